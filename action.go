@@ -0,0 +1,439 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type (
+	// ActionEventSourceType source of the events handled by the action
+	// see "eventsource" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object
+	ActionEventSourceType int
+
+	// ActionStatusType status of the action
+	// see "status" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object
+	ActionStatusType int
+
+	// ActionEvalType condition evaluation method of the action filter
+	// see "evaltype" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-filter
+	ActionEvalType int
+
+	// ActionConditionType type of an action filter condition
+	// see "conditiontype" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-filter-condition
+	ActionConditionType int
+
+	// ActionOperatorType condition operator
+	// see "operator" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-filter-condition
+	ActionOperatorType int
+
+	// ActionOperationType type of an action operation
+	// see "operationtype" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-operation
+	ActionOperationType int
+)
+
+const (
+	// ActionEventSourceTrigger event created by a trigger
+	ActionEventSourceTrigger ActionEventSourceType = 0
+	// ActionEventSourceDiscovery event created by a discovery rule
+	ActionEventSourceDiscovery ActionEventSourceType = 1
+	// ActionEventSourceAutoregistration event created by active agent autoregistration
+	ActionEventSourceAutoregistration ActionEventSourceType = 2
+	// ActionEventSourceInternal internal event
+	ActionEventSourceInternal ActionEventSourceType = 3
+	// ActionEventSourceService event created on service status update
+	ActionEventSourceService ActionEventSourceType = 4
+)
+
+const (
+	// ActionEnabled action is enabled
+	ActionEnabled ActionStatusType = 0
+	// ActionDisabled action is disabled
+	ActionDisabled ActionStatusType = 1
+)
+
+const (
+	// ActionEvalAndOr and/or
+	ActionEvalAndOr ActionEvalType = 0
+	// ActionEvalAnd and
+	ActionEvalAnd ActionEvalType = 1
+	// ActionEvalOr or
+	ActionEvalOr ActionEvalType = 2
+	// ActionEvalCustom custom expression in Formula
+	ActionEvalCustom ActionEvalType = 3
+)
+
+const (
+	// ActionConditionHostGroup host group
+	ActionConditionHostGroup ActionConditionType = 0
+	// ActionConditionHost host
+	ActionConditionHost ActionConditionType = 1
+	// ActionConditionTrigger trigger
+	ActionConditionTrigger ActionConditionType = 2
+	// ActionConditionEventName event name
+	ActionConditionEventName ActionConditionType = 3
+	// ActionConditionTriggerSeverity trigger severity
+	ActionConditionTriggerSeverity ActionConditionType = 4
+	// ActionConditionTimePeriod time period
+	ActionConditionTimePeriod ActionConditionType = 6
+	// ActionConditionHostIP host IP
+	ActionConditionHostIP ActionConditionType = 7
+	// ActionConditionDiscoveredService discovered service type
+	ActionConditionDiscoveredService ActionConditionType = 8
+	// ActionConditionDiscoveredPort discovered service port
+	ActionConditionDiscoveredPort ActionConditionType = 9
+	// ActionConditionDiscoveryStatus discovery status
+	ActionConditionDiscoveryStatus ActionConditionType = 10
+	// ActionConditionUptimeDowntime uptime or downtime duration
+	ActionConditionUptimeDowntime ActionConditionType = 11
+	// ActionConditionReceivedValue received value
+	ActionConditionReceivedValue ActionConditionType = 12
+	// ActionConditionHostTemplate host template
+	ActionConditionHostTemplate ActionConditionType = 13
+	// ActionConditionProblemSuppressed problem is suppressed
+	ActionConditionProblemSuppressed ActionConditionType = 16
+	// ActionConditionDiscoveryRule discovery rule
+	ActionConditionDiscoveryRule ActionConditionType = 18
+	// ActionConditionDiscoveryCheck discovery check
+	ActionConditionDiscoveryCheck ActionConditionType = 19
+	// ActionConditionProxy proxy
+	ActionConditionProxy ActionConditionType = 20
+	// ActionConditionDiscoveryObject discovery object
+	ActionConditionDiscoveryObject ActionConditionType = 21
+	// ActionConditionHostName host name
+	ActionConditionHostName ActionConditionType = 22
+	// ActionConditionEventType event type
+	ActionConditionEventType ActionConditionType = 23
+	// ActionConditionHostMetadata host metadata
+	ActionConditionHostMetadata ActionConditionType = 24
+	// ActionConditionEventTag event tag
+	ActionConditionEventTag ActionConditionType = 25
+	// ActionConditionEventTagValue event tag value
+	ActionConditionEventTagValue ActionConditionType = 26
+	// ActionConditionService service
+	ActionConditionService ActionConditionType = 27
+	// ActionConditionServiceName service name
+	ActionConditionServiceName ActionConditionType = 28
+)
+
+const (
+	// ActionOperatorEqual equals
+	ActionOperatorEqual ActionOperatorType = 0
+	// ActionOperatorNotEqual does not equal
+	ActionOperatorNotEqual ActionOperatorType = 1
+	// ActionOperatorContains contains
+	ActionOperatorContains ActionOperatorType = 2
+	// ActionOperatorNotContains does not contain
+	ActionOperatorNotContains ActionOperatorType = 3
+	// ActionOperatorIn in
+	ActionOperatorIn ActionOperatorType = 4
+	// ActionOperatorGreaterOrEq is greater than or equals
+	ActionOperatorGreaterOrEq ActionOperatorType = 5
+	// ActionOperatorLessOrEq is less than or equals
+	ActionOperatorLessOrEq ActionOperatorType = 6
+	// ActionOperatorNotIn not in
+	ActionOperatorNotIn ActionOperatorType = 7
+	// ActionOperatorMatches matches
+	ActionOperatorMatches ActionOperatorType = 8
+	// ActionOperatorNotMatches does not match
+	ActionOperatorNotMatches ActionOperatorType = 9
+	// ActionOperatorYes yes
+	ActionOperatorYes ActionOperatorType = 10
+	// ActionOperatorNo no
+	ActionOperatorNo ActionOperatorType = 11
+)
+
+const (
+	// ActionOperationMessage send message
+	ActionOperationMessage ActionOperationType = 0
+	// ActionOperationCommand global script
+	ActionOperationCommand ActionOperationType = 1
+	// ActionOperationAddHost add host
+	ActionOperationAddHost ActionOperationType = 2
+	// ActionOperationRemoveHost remove host
+	ActionOperationRemoveHost ActionOperationType = 3
+	// ActionOperationAddToHostGroup add to host group
+	ActionOperationAddToHostGroup ActionOperationType = 4
+	// ActionOperationRemoveFromHostGroup remove from host group
+	ActionOperationRemoveFromHostGroup ActionOperationType = 5
+	// ActionOperationLinkTemplate link template
+	ActionOperationLinkTemplate ActionOperationType = 6
+	// ActionOperationUnlinkTemplate unlink template
+	ActionOperationUnlinkTemplate ActionOperationType = 7
+	// ActionOperationEnableHost enable host
+	ActionOperationEnableHost ActionOperationType = 8
+	// ActionOperationDisableHost disable host
+	ActionOperationDisableHost ActionOperationType = 9
+	// ActionOperationSetInventoryMode set host inventory mode
+	ActionOperationSetInventoryMode ActionOperationType = 10
+	// ActionOperationNotifyRecipients notify all involved, recovery operations only
+	ActionOperationNotifyRecipients ActionOperationType = 11
+	// ActionOperationNotifyUpdateRecipients notify all involved, update operations only
+	ActionOperationNotifyUpdateRecipients ActionOperationType = 12
+	// ActionOperationAddHostTags add host tags
+	ActionOperationAddHostTags ActionOperationType = 13
+	// ActionOperationRemoveHostTags remove host tags
+	ActionOperationRemoveHostTags ActionOperationType = 14
+)
+
+// ActionFilterCondition condition of an action filter
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-filter-condition
+type ActionFilterCondition struct {
+	ConditionType ActionConditionType `json:"conditiontype,string"`
+	Operator      ActionOperatorType  `json:"operator,string"`
+	Value         string              `json:"value"`
+	Value2        string              `json:"value2,omitempty"`
+	FormulaID     string              `json:"formulaid,omitempty"`
+}
+
+// ActionFilterConditions is an array of ActionFilterCondition
+type ActionFilterConditions []ActionFilterCondition
+
+// ActionFilter filter of an action
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-filter
+type ActionFilter struct {
+	Conditions ActionFilterConditions `json:"conditions"`
+	EvalType   ActionEvalType         `json:"evaltype,string"`
+	Formula    string                 `json:"formula,omitempty"`
+
+	// EvalFormula is read only, it is not sent back on create or update
+	EvalFormula string `json:"-"`
+}
+
+// UnmarshalJSON decodes the read only eval_formula of f.
+func (f *ActionFilter) UnmarshalJSON(data []byte) error {
+	type plain ActionFilter
+	aux := struct {
+		*plain
+		EvalFormula string `json:"eval_formula"`
+	}{plain: (*plain)(f)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	f.EvalFormula = aux.EvalFormula
+	return nil
+}
+
+// ActionOpMessage message sent by an operation
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-operation-message
+type ActionOpMessage struct {
+	// DefaultMsg "1" uses the message text of the media type
+	DefaultMsg  string `json:"default_msg,omitempty"`
+	MediaTypeID string `json:"mediatypeid,omitempty"`
+	Subject     string `json:"subject,omitempty"`
+	Message     string `json:"message,omitempty"`
+}
+
+// ActionOpCommand global script run by an operation
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-operation-command
+type ActionOpCommand struct {
+	ScriptID string `json:"scriptid"`
+}
+
+// ActionOpCondition condition of an escalation step
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-operation-condition
+type ActionOpCondition struct {
+	OpConditionID string              `json:"opconditionid,omitempty"`
+	ConditionType ActionConditionType `json:"conditiontype,string"`
+	Operator      ActionOperatorType  `json:"operator,string"`
+	Value         string              `json:"value"`
+}
+
+// ActionOpUserGroup user group notified by an operation
+type ActionOpUserGroup struct {
+	UserGroupID string `json:"usrgrpid"`
+}
+
+// ActionOpUser user notified by an operation
+type ActionOpUser struct {
+	UserID string `json:"userid"`
+}
+
+// ActionOpHostGroup host group used by an operation
+type ActionOpHostGroup struct {
+	GroupID string `json:"groupid"`
+}
+
+// ActionOpHost host used by an operation
+type ActionOpHost struct {
+	HostID string `json:"hostid"`
+}
+
+// ActionOpTemplate template linked or unlinked by an operation
+type ActionOpTemplate struct {
+	TemplateID string `json:"templateid"`
+}
+
+// ActionOpInventory inventory mode set by an operation
+type ActionOpInventory struct {
+	InventoryMode InventoryMode `json:"inventory_mode,string"`
+}
+
+// ActionOpTag host tag added or removed by an operation
+type ActionOpTag struct {
+	Tag   string `json:"tag"`
+	Value string `json:"value,omitempty"`
+}
+
+// ActionOperation operation, recovery operation or update operation of an action
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object#action-operation
+type ActionOperation struct {
+	OperationID   string              `json:"operationid,omitempty"`
+	OperationType ActionOperationType `json:"operationtype,string"`
+	// escalation fields, operations of trigger and service actions only
+	EscPeriod   string         `json:"esc_period,omitempty"`
+	EscStepFrom int            `json:"esc_step_from,omitempty,string"`
+	EscStepTo   int            `json:"esc_step_to,omitempty,string"`
+	EvalType    ActionEvalType `json:"evaltype,omitempty,string"`
+
+	OpMessage    *ActionOpMessage    `json:"opmessage,omitempty"`
+	OpMessageGrp []ActionOpUserGroup `json:"opmessage_grp,omitempty"`
+	OpMessageUsr []ActionOpUser      `json:"opmessage_usr,omitempty"`
+	OpCommand    *ActionOpCommand    `json:"opcommand,omitempty"`
+	OpCommandGrp []ActionOpHostGroup `json:"opcommand_grp,omitempty"`
+	OpCommandHst []ActionOpHost      `json:"opcommand_hst,omitempty"`
+	OpConditions []ActionOpCondition `json:"opconditions,omitempty"`
+	OpGroup      []ActionOpHostGroup `json:"opgroup,omitempty"`
+	OpTemplate   []ActionOpTemplate  `json:"optemplate,omitempty"`
+	OpInventory  *ActionOpInventory  `json:"opinventory,omitempty"`
+	OpTag        []ActionOpTag       `json:"optag,omitempty"`
+}
+
+// ActionOperations is an array of ActionOperation
+type ActionOperations []ActionOperation
+
+// Action represent Zabbix action object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/object
+type Action struct {
+	ActionID         string                `json:"actionid,omitempty"`
+	Name             string                `json:"name"`
+	EventSource      ActionEventSourceType `json:"eventsource,string"`
+	EscPeriod        string                `json:"esc_period,omitempty"`
+	Status           ActionStatusType      `json:"status,string"`
+	PauseSymptoms    string                `json:"pause_symptoms,omitempty"`
+	PauseSuppressed  string                `json:"pause_suppressed,omitempty"`
+	NotifyIfCanceled string                `json:"notify_if_canceled,omitempty"`
+
+	Filter             *ActionFilter    `json:"filter,omitempty"`
+	Operations         ActionOperations `json:"operations,omitempty"`
+	RecoveryOperations ActionOperations `json:"recovery_operations,omitempty"`
+	UpdateOperations   ActionOperations `json:"update_operations,omitempty"`
+}
+
+// Actions is an array of Action
+type Actions []Action
+
+// ActionsGet Wrapper for action.get
+// Selects the filter and all operations unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/get
+func (api *API) ActionsGet(params Params) (res Actions, err error) {
+	return api.ActionsGetContext(context.Background(), params)
+}
+
+// ActionsGetContext is like ActionsGet but uses ctx for the underlying API calls.
+func (api *API) ActionsGetContext(ctx context.Context, params Params) (res Actions, err error) {
+	for _, key := range []string{"output", "selectFilter", "selectOperations", "selectRecoveryOperations", "selectUpdateOperations"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "action.get", params, &res)
+	return
+}
+
+// ActionGetByID Gets action by ID only if there is exactly 1 matching action.
+func (api *API) ActionGetByID(id string) (res *Action, err error) {
+	return api.ActionGetByIDContext(context.Background(), id)
+}
+
+// ActionGetByIDContext is like ActionGetByID but uses ctx for the underlying API calls.
+func (api *API) ActionGetByIDContext(ctx context.Context, id string) (res *Action, err error) {
+	actions, err := api.ActionsGetContext(ctx, Params{"actionids": id})
+	if err != nil {
+		return
+	}
+
+	if len(actions) == 1 {
+		res = &actions[0]
+	} else {
+		e := ExpectedOneResult(len(actions))
+		err = &e
+	}
+	return
+}
+
+// ActionsCreate Wrapper for action.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/create
+func (api *API) ActionsCreate(actions Actions) (err error) {
+	return api.ActionsCreateContext(context.Background(), actions)
+}
+
+// ActionsCreateContext is like ActionsCreate but uses ctx for the underlying API calls.
+func (api *API) ActionsCreateContext(ctx context.Context, actions Actions) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "action.create", actions)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	actionids := result["actionids"].([]interface{})
+	for i, id := range actionids {
+		actions[i].ActionID = id.(string)
+	}
+	return
+}
+
+// ActionsUpdate Wrapper for action.update
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/update
+func (api *API) ActionsUpdate(actions Actions) (err error) {
+	return api.ActionsUpdateContext(context.Background(), actions)
+}
+
+// ActionsUpdateContext is like ActionsUpdate but uses ctx for the underlying API calls.
+func (api *API) ActionsUpdateContext(ctx context.Context, actions Actions) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "action.update", actions)
+	return
+}
+
+// ActionsDelete Wrapper for action.delete
+// Cleans ActionID in all actions elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/delete
+func (api *API) ActionsDelete(actions Actions) (err error) {
+	return api.ActionsDeleteContext(context.Background(), actions)
+}
+
+// ActionsDeleteContext is like ActionsDelete but uses ctx for the underlying API calls.
+func (api *API) ActionsDeleteContext(ctx context.Context, actions Actions) (err error) {
+	ids := make([]string, len(actions))
+	for i, action := range actions {
+		ids[i] = action.ActionID
+	}
+
+	err = api.ActionsDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range actions {
+			actions[i].ActionID = ""
+		}
+	}
+	return
+}
+
+// ActionsDeleteByIds Wrapper for action.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/action/delete
+func (api *API) ActionsDeleteByIds(ids []string) (err error) {
+	return api.ActionsDeleteByIdsContext(context.Background(), ids)
+}
+
+// ActionsDeleteByIdsContext is like ActionsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) ActionsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "action.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	actionids := result["actionids"].([]interface{})
+	if len(ids) != len(actionids) {
+		err = &ExpectedMore{len(ids), len(actionids)}
+	}
+	return
+}
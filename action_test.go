@@ -0,0 +1,110 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestActionsGet(t *testing.T) {
+	api := getAPI(t)
+
+	_, err := api.ActionsGet(zapi.Params{"filter": map[string]interface{}{"eventsource": zapi.ActionEventSourceTrigger}})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestActionFilterEvalFormula(t *testing.T) {
+	var action zapi.Action
+	data := `{"actionid":"3","name":"a","filter":{"evaltype":"0","formula":"","eval_formula":"A and B","conditions":[]}}`
+	if err := json.Unmarshal([]byte(data), &action); err != nil {
+		t.Fatal(err)
+	}
+	if action.Filter == nil || action.Filter.EvalFormula != "A and B" || action.Filter.EvalType != zapi.ActionEvalAndOr {
+		t.Fatalf("unexpected filter %#v", action.Filter)
+	}
+
+	// the read only eval_formula is not sent back on update
+	b, err := json.Marshal(action)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "eval_formula") {
+		t.Errorf("eval_formula sent in %s", b)
+	}
+}
+
+func TestActionsFake(t *testing.T) {
+	api, _ := getFakeAPI(t)
+
+	actions := zapi.Actions{{
+		Name:        "Notify admins",
+		EventSource: zapi.ActionEventSourceTrigger,
+		EscPeriod:   "1h",
+		Status:      zapi.ActionEnabled,
+		Filter: &zapi.ActionFilter{
+			EvalType: zapi.ActionEvalAnd,
+			Conditions: zapi.ActionFilterConditions{
+				{ConditionType: zapi.ActionConditionTriggerSeverity, Operator: zapi.ActionOperatorGreaterOrEq, Value: "4"},
+				{ConditionType: zapi.ActionConditionHostGroup, Operator: zapi.ActionOperatorEqual, Value: "2"},
+			},
+		},
+		Operations: zapi.ActionOperations{{
+			OperationType: zapi.ActionOperationMessage,
+			EscStepFrom:   1,
+			EscStepTo:     2,
+			OpMessage:     &zapi.ActionOpMessage{DefaultMsg: "1", MediaTypeID: "1"},
+			OpMessageGrp:  []zapi.ActionOpUserGroup{{UserGroupID: "7"}},
+		}},
+		RecoveryOperations: zapi.ActionOperations{{
+			OperationType: zapi.ActionOperationNotifyRecipients,
+			OpMessage:     &zapi.ActionOpMessage{DefaultMsg: "1"},
+		}},
+		UpdateOperations: zapi.ActionOperations{{
+			OperationType: zapi.ActionOperationCommand,
+			OpCommand:     &zapi.ActionOpCommand{ScriptID: "3"},
+			OpCommandHst:  []zapi.ActionOpHost{{HostID: "0"}},
+		}},
+	}}
+	if err := api.ActionsCreate(actions); err != nil {
+		t.Fatal(err)
+	}
+	if actions[0].ActionID == "" {
+		t.Fatal("action id not set")
+	}
+	if err := api.ActionsCreate(zapi.Actions{{Name: "Notify admins"}}); err == nil {
+		t.Error("expected duplicate name error")
+	}
+
+	action, err := api.ActionGetByID(actions[0].ActionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if action.Filter == nil || len(action.Filter.Conditions) != 2 || action.Filter.Conditions[0].ConditionType != zapi.ActionConditionTriggerSeverity {
+		t.Errorf("unexpected filter %#v", action.Filter)
+	}
+	if len(action.Operations) != 1 || action.Operations[0].EscStepTo != 2 || action.Operations[0].OpMessageGrp[0].UserGroupID != "7" {
+		t.Errorf("unexpected operations %#v", action.Operations)
+	}
+	if len(action.RecoveryOperations) != 1 || len(action.UpdateOperations) != 1 || action.UpdateOperations[0].OpCommand.ScriptID != "3" {
+		t.Errorf("unexpected recovery/update operations %#v %#v", action.RecoveryOperations, action.UpdateOperations)
+	}
+
+	action.Status = zapi.ActionDisabled
+	if err = api.ActionsUpdate(zapi.Actions{*action}); err != nil {
+		t.Fatal(err)
+	}
+	if action, err = api.ActionGetByID(actions[0].ActionID); err != nil || action.Status != zapi.ActionDisabled {
+		t.Fatalf("update not applied: %v %v", action, err)
+	}
+
+	if err = api.ActionsDelete(actions); err != nil {
+		t.Fatal(err)
+	}
+	if actions[0].ActionID != "" {
+		t.Error("action id not cleared")
+	}
+}
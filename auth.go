@@ -0,0 +1,187 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Authenticator obtains the auth value (session ID or API token) sent with requests.
+// The API calls it again when a request fails because the session expired.
+type Authenticator interface {
+	Authenticate(ctx context.Context, api *API) (auth string, err error)
+}
+
+// AuthenticatorFunc adapts a function to Authenticator.
+type AuthenticatorFunc func(ctx context.Context, api *API) (auth string, err error)
+
+// Authenticate calls f.
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, api *API) (string, error) {
+	return f(ctx, api)
+}
+
+// TokenAuthenticator authenticates with a static API token.
+// A token can not be renewed, so expired tokens are reported as errors.
+type TokenAuthenticator string
+
+// Authenticate returns the token.
+func (t TokenAuthenticator) Authenticate(ctx context.Context, api *API) (string, error) {
+	return string(t), nil
+}
+
+// PasswordAuthenticator authenticates by calling "user.login" with username and password.
+type PasswordAuthenticator struct {
+	User     string
+	Password string
+}
+
+// Authenticate logs in and returns the new session ID.
+func (p PasswordAuthenticator) Authenticate(ctx context.Context, api *API) (string, error) {
+	return api.login(ctx, p.User, p.Password)
+}
+
+// noRefreshMethods are not replayed when the session expires. Lowercase.
+var noRefreshMethods = map[string]bool{
+	"apiinfo.version":          true,
+	"user.login":               true,
+	"user.logout":              true,
+	"user.checkauthentication": true,
+}
+
+// SetAuthenticator sets the authenticator used to log in again when the session expires.
+// Login and Token set it too. Nil disables automatic re-authentication.
+func (api *API) SetAuthenticator(a Authenticator) {
+	api.authMu.Lock()
+	defer api.authMu.Unlock()
+	api.authenticator = a
+}
+
+// Authenticate fills api.Auth using the authenticator set with SetAuthenticator.
+func (api *API) Authenticate() (err error) {
+	return api.AuthenticateContext(context.Background())
+}
+
+// AuthenticateContext is like Authenticate but uses ctx for the underlying API calls.
+func (api *API) AuthenticateContext(ctx context.Context) (err error) {
+	api.authMu.RLock()
+	a := api.authenticator
+	api.authMu.RUnlock()
+	if a == nil {
+		return errors.New("zabbix: no authenticator set")
+	}
+
+	auth, err := a.Authenticate(ctx, api)
+	if err != nil {
+		return
+	}
+	api.setAuth(auth, a)
+	return
+}
+
+func (api *API) authToken() string {
+	api.authMu.RLock()
+	defer api.authMu.RUnlock()
+	return api.Auth
+}
+
+func (api *API) setAuth(auth string, a Authenticator) {
+	api.authMu.Lock()
+	defer api.authMu.Unlock()
+	api.Auth = auth
+	api.authenticator = a
+}
+
+// refreshable reports whether a method failing with an expired session may be replayed.
+func (api *API) refreshable(method string) bool {
+	api.authMu.RLock()
+	defer api.authMu.RUnlock()
+	return api.authenticator != nil && !noRefreshMethods[strings.ToLower(method)]
+}
+
+// sessionExpired reports whether the response body b is a session expired error.
+func sessionExpired(b []byte) bool {
+	var res RawResponse
+	if json.Unmarshal(b, &res) != nil || res.Error == nil {
+		return false
+	}
+	return errors.Is(res.Error, ErrSessionExpired)
+}
+
+// reauthenticate renews the auth value rejected as stale and returns the new one.
+// Concurrent callers wait for one another and reuse the value if it was already renewed.
+func (api *API) reauthenticate(ctx context.Context, stale string) (auth string, err error) {
+	api.refreshMu.Lock()
+	defer api.refreshMu.Unlock()
+
+	api.authMu.RLock()
+	auth, a := api.Auth, api.authenticator
+	api.authMu.RUnlock()
+	if auth != stale || a == nil {
+		return
+	}
+
+	auth, err = a.Authenticate(ctx, api)
+	if err != nil {
+		return
+	}
+	api.authMu.Lock()
+	api.Auth = auth
+	api.authMu.Unlock()
+	return
+}
+
+// Session describes the user of a session or API token.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/user/checkauthentication
+type Session struct {
+	UserID    string `json:"userid"`
+	Username  string `json:"username"`
+	Name      string `json:"name,omitempty"`
+	Surname   string `json:"surname,omitempty"`
+	RoleID    string `json:"roleid,omitempty"`
+	SessionID string `json:"sessionid,omitempty"`
+	Lang      string `json:"lang,omitempty"`
+	Timezone  string `json:"timezone,omitempty"`
+}
+
+// CheckAuthentication Wrapper for user.checkAuthentication
+// Checks the current session ID, or API token when authenticated with Token.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/user/checkauthentication
+func (api *API) CheckAuthentication() (res *Session, err error) {
+	return api.CheckAuthenticationContext(context.Background())
+}
+
+// CheckAuthenticationContext is like CheckAuthentication but uses ctx for the underlying API calls.
+func (api *API) CheckAuthenticationContext(ctx context.Context) (res *Session, err error) {
+	api.authMu.RLock()
+	auth, a := api.Auth, api.authenticator
+	api.authMu.RUnlock()
+
+	params := Params{"sessionid": auth}
+	if _, ok := a.(TokenAuthenticator); ok {
+		params = Params{"token": auth}
+	}
+	// the session or token is checked from params, the method is rejected when called with auth
+	res = &Session{}
+	err = api.callWithoutAuth(ctx, "user.checkAuthentication", params, res)
+	if err != nil {
+		res = nil
+	}
+	return
+}
+
+// Logout Wrapper for user.logout
+// Clears api.Auth and the authenticator if call succeed.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/user/logout
+func (api *API) Logout() (err error) {
+	return api.LogoutContext(context.Background())
+}
+
+// LogoutContext is like Logout but uses ctx for the underlying API calls.
+func (api *API) LogoutContext(ctx context.Context) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "user.logout", []string{})
+	if err == nil {
+		api.setAuth("", nil)
+	}
+	return
+}
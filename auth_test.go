@@ -0,0 +1,110 @@
+package zabbix_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+	"github.com/kgeroczi/go-zabbix-api/zabbixtest"
+)
+
+func TestSessionRefreshFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+	srv.Add("hostgroup", map[string]interface{}{"name": "Linux servers"})
+
+	before := api.Auth
+	srv.ExpireSessions()
+	groups, err := api.HostGroupsGet(zapi.Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 1 {
+		t.Errorf("expected 1 host group, got %d", len(groups))
+	}
+	if api.Auth == "" || api.Auth == before {
+		t.Errorf("session was not renewed: %q", api.Auth)
+	}
+
+	session, err := api.CheckAuthentication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Username != zabbixtest.DefaultUser || session.SessionID != api.Auth {
+		t.Errorf("unexpected session %#v", session)
+	}
+
+	if err = api.Logout(); err != nil {
+		t.Fatal(err)
+	}
+	if api.Auth != "" {
+		t.Errorf("auth not cleared after logout: %q", api.Auth)
+	}
+	if _, err = api.HostGroupsGet(zapi.Params{}); err == nil {
+		t.Error("expected error after logout")
+	}
+}
+
+func TestSessionRefreshConcurrentFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	var logins int32
+	api.SetAuthenticator(zapi.AuthenticatorFunc(func(ctx context.Context, api *zapi.API) (string, error) {
+		atomic.AddInt32(&logins, 1)
+		return zapi.PasswordAuthenticator{User: zabbixtest.DefaultUser, Password: zabbixtest.DefaultPassword}.Authenticate(ctx, api)
+	}))
+	srv.ExpireSessions()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < cap(errs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := api.HostsGet(zapi.Params{})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if logins != 1 {
+		t.Errorf("expected 1 login, got %d", logins)
+	}
+}
+
+func TestTokenAuthFake(t *testing.T) {
+	srv := zabbixtest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddToken("secret-token")
+
+	api, err := zapi.NewAPI(zapi.Config{Url: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = api.Token("secret-token"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = api.HostsGet(zapi.Params{}); err != nil {
+		t.Fatal(err)
+	}
+	session, err := api.CheckAuthentication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Username != zabbixtest.DefaultUser {
+		t.Errorf("unexpected session %#v", session)
+	}
+
+	// a static token can not be renewed
+	srv.ExpireSessions()
+	if _, err = api.HostsGet(zapi.Params{}); !errors.Is(err, zapi.ErrSessionExpired) {
+		t.Errorf("expired token: unexpected error %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package zabbix
+
+import (
+	"context"
+)
+
+type (
+	// AuthenticationType default authentication of users
+	// see "authentication_type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/authentication/object
+	AuthenticationType int
+
+	// HTTPLoginFormType default login form of HTTP authentication
+	// see "http_login_form" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/authentication/object
+	HTTPLoginFormType int
+
+	// PasswordRules bitmask of password complexity rules
+	// see "passwd_check_rules" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/authentication/object
+	PasswordRules int
+)
+
+const (
+	// AuthenticationInternal internal authentication
+	AuthenticationInternal AuthenticationType = 0
+	// AuthenticationLDAP LDAP authentication
+	AuthenticationLDAP AuthenticationType = 1
+)
+
+const (
+	// HTTPLoginFormZabbix Zabbix login form
+	HTTPLoginFormZabbix HTTPLoginFormType = 0
+	// HTTPLoginFormHTTP HTTP login form
+	HTTPLoginFormHTTP HTTPLoginFormType = 1
+)
+
+const (
+	// PasswordMixedCase must contain uppercase and lowercase Latin letters
+	PasswordMixedCase PasswordRules = 1
+	// PasswordDigits must contain digits
+	PasswordDigits PasswordRules = 2
+	// PasswordSpecial must contain special characters
+	PasswordSpecial PasswordRules = 4
+	// PasswordNotSimple must not be easy to guess
+	PasswordNotSimple PasswordRules = 8
+)
+
+// AuthenticationSettings represent Zabbix authentication object
+// Flags like HTTPAuthEnabled and LDAPCaseSensitive are 0 (disabled) or 1 (enabled).
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/authentication/object
+type AuthenticationSettings struct {
+	AuthenticationType AuthenticationType `json:"authentication_type,string"`
+
+	HTTPAuthEnabled   int               `json:"http_auth_enabled,string"`
+	HTTPLoginForm     HTTPLoginFormType `json:"http_login_form,string"`
+	HTTPStripDomains  string            `json:"http_strip_domains"`
+	HTTPCaseSensitive int               `json:"http_case_sensitive,string"`
+
+	LDAPAuthEnabled   int    `json:"ldap_auth_enabled,string"`
+	LDAPCaseSensitive int    `json:"ldap_case_sensitive,string"`
+	LDAPUserDirectory string `json:"ldap_userdirectoryid"`
+	LDAPJITStatus     int    `json:"ldap_jit_status,string"`
+
+	SAMLAuthEnabled   int `json:"saml_auth_enabled,string"`
+	SAMLCaseSensitive int `json:"saml_case_sensitive,string"`
+	SAMLJITStatus     int `json:"saml_jit_status,string"`
+
+	PasswordMinLength int           `json:"passwd_min_length,string"`
+	PasswordRules     PasswordRules `json:"passwd_check_rules,string"`
+
+	// JITProvisionInterval time between provisioning of LDAP users, e.g. "1h"
+	JITProvisionInterval string `json:"jit_provision_interval"`
+	// DisabledUserGroupID group of users deprovisioned by their directory
+	DisabledUserGroupID string `json:"disabled_usrgrpid"`
+
+	MFAStatus MFAStatusType `json:"mfa_status,string"`
+	MFAID     string        `json:"mfaid"`
+}
+
+// AuthenticationGet Wrapper for authentication.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/authentication/get
+func (api *API) AuthenticationGet() (res *AuthenticationSettings, err error) {
+	return api.AuthenticationGetContext(context.Background())
+}
+
+// AuthenticationGetContext is like AuthenticationGet but uses ctx for the underlying API calls.
+func (api *API) AuthenticationGetContext(ctx context.Context) (res *AuthenticationSettings, err error) {
+	res = &AuthenticationSettings{}
+	err = api.CallWithErrorParseContext(ctx, "authentication.get", Params{"output": "extend"}, res)
+	if err != nil {
+		res = nil
+	}
+	return
+}
+
+// AuthenticationUpdate Wrapper for authentication.update
+// Sends only the fields that differ from the current settings and returns their names,
+// so start from AuthenticationGet rather than the zero value.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/authentication/update
+func (api *API) AuthenticationUpdate(settings *AuthenticationSettings) (changed []string, err error) {
+	return api.AuthenticationUpdateContext(context.Background(), settings)
+}
+
+// AuthenticationUpdateContext is like AuthenticationUpdate but uses ctx for the underlying API calls.
+func (api *API) AuthenticationUpdateContext(ctx context.Context, settings *AuthenticationSettings) (changed []string, err error) {
+	return api.updateChanged(ctx, "authentication", settings, nil, nil)
+}
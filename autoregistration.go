@@ -0,0 +1,58 @@
+package zabbix
+
+import (
+	"context"
+)
+
+// AutoregistrationTLSAccept bitmask of accepted active agent connections
+// see "tls_accept" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/autoregistration/object
+type AutoregistrationTLSAccept int
+
+const (
+	// AutoregistrationUnencrypted unencrypted connections
+	AutoregistrationUnencrypted AutoregistrationTLSAccept = 1
+	// AutoregistrationPSK TLS connections with a pre-shared key
+	AutoregistrationPSK AutoregistrationTLSAccept = 2
+)
+
+// Autoregistration represent Zabbix autoregistration object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/autoregistration/object
+type Autoregistration struct {
+	TLSAccept AutoregistrationTLSAccept `json:"tls_accept,string"`
+
+	// Fields below are write only, they are sent when not empty
+	TLSPSKIdentity string `json:"tls_psk_identity,omitempty"`
+	TLSPSK         string `json:"tls_psk,omitempty"`
+}
+
+// autoregistrationWriteOnly fields of Autoregistration never returned by AutoregistrationGet.
+var autoregistrationWriteOnly = []string{"tls_psk_identity", "tls_psk"}
+
+// AutoregistrationGet Wrapper for autoregistration.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/autoregistration/get
+func (api *API) AutoregistrationGet() (res *Autoregistration, err error) {
+	return api.AutoregistrationGetContext(context.Background())
+}
+
+// AutoregistrationGetContext is like AutoregistrationGet but uses ctx for the underlying API calls.
+func (api *API) AutoregistrationGetContext(ctx context.Context) (res *Autoregistration, err error) {
+	res = &Autoregistration{}
+	err = api.CallWithErrorParseContext(ctx, "autoregistration.get", Params{"output": "extend"}, res)
+	if err != nil {
+		res = nil
+	}
+	return
+}
+
+// AutoregistrationUpdate Wrapper for autoregistration.update
+// Sends only the fields that differ from the current settings and returns their names.
+// The write only PSK fields can not be compared, so they are sent whenever set.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/autoregistration/update
+func (api *API) AutoregistrationUpdate(autoregistration *Autoregistration) (changed []string, err error) {
+	return api.AutoregistrationUpdateContext(context.Background(), autoregistration)
+}
+
+// AutoregistrationUpdateContext is like AutoregistrationUpdate but uses ctx for the underlying API calls.
+func (api *API) AutoregistrationUpdateContext(ctx context.Context, autoregistration *Autoregistration) (changed []string, err error) {
+	return api.updateChanged(ctx, "autoregistration", autoregistration, nil, autoregistrationWriteOnly)
+}
@@ -0,0 +1,175 @@
+package zabbix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCallContextCancel(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	api := &API{url: srv.URL, Config: Config{Version: 70000}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := api.HostsGetContext(ctx, Params{})
+	if err == nil {
+		t.Fatal("expected error from cancelled context, got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Error("request was not aborted by context")
+	}
+}
+
+// flakyServer answers with the given HTTP status failures times before succeeding.
+func flakyServer(t *testing.T, failures int, status int) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if int(atomic.AddInt32(&calls, 1)) <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","result":[],"id":1}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func TestRetryIdempotent(t *testing.T) {
+	srv, calls := flakyServer(t, 2, http.StatusBadGateway)
+	api := &API{url: srv.URL, Config: Config{Version: 70000, Retry: RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}}}
+
+	_, err := api.HostsGet(Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", *calls)
+	}
+}
+
+func TestRetrySkipsMutating(t *testing.T) {
+	srv, calls := flakyServer(t, 1, http.StatusBadGateway)
+	api := &API{url: srv.URL, Config: Config{Version: 70000, Retry: RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}}}
+
+	_, err := api.CallWithError("host.delete", []string{"1"})
+	if err == nil {
+		t.Fatal("expected error for non-retried 502 response")
+	}
+	if *calls != 1 {
+		t.Errorf("expected 1 attempt, got %d", *calls)
+	}
+
+	api.Config.Retry.RetryMutating = true
+	atomic.StoreInt32(calls, 0)
+	_, err = api.CallWithError("host.delete", []string{"1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Errorf("expected 2 attempts with RetryMutating, got %d", *calls)
+	}
+}
+
+func TestRetryErrorCode(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Write([]byte(`{"jsonrpc":"2.0","error":{"code":-32603,"message":"Internal error.","data":"DB error"},"id":1}`))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","result":"7.0.0","id":1}`))
+	}))
+	defer srv.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	api := &API{url: srv.URL, Config: Config{Version: 70000, Retry: policy}}
+
+	v, err := api.Version()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "7.0.0" || calls != 2 {
+		t.Errorf("expected version 7.0.0 after 2 attempts, got %q after %d", v, calls)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+	expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second}
+	for i, want := range expected {
+		if got := p.backoff(i + 1); got != want {
+			t.Errorf("attempt %d: expected %s, got %s", i+1, want, got)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := p.backoff(1); d < 50*time.Millisecond || d > 100*time.Millisecond {
+			t.Fatalf("jittered delay out of range: %s", d)
+		}
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	cases := []struct {
+		err    *Error
+		target error
+		want   bool
+	}{
+		{&Error{Code: CodeInvalidParams, Data: `Host with the same name "a" already exists.`}, ErrAlreadyExists, true},
+		{&Error{Code: CodeInvalidParams, Data: "Session terminated, re-login, please."}, ErrSessionExpired, true},
+		{&Error{Code: CodeApplication, Data: "No permissions to referred object or it does not exist!"}, ErrNotFound, true},
+		{&Error{Code: CodeApplication, Data: "No permissions to referred object or it does not exist!"}, ErrPermissionDenied, true},
+		{&Error{Code: CodeApplication, Data: "No permissions to referred object or it does not exist!"}, ErrInvalidParams, false},
+		{&Error{Code: CodeInvalidParams, Data: "You do not have permission to perform this operation."}, ErrPermissionDenied, true},
+		{&Error{Code: CodeInvalidParams, Data: `Host with the same name "a" already exists.`}, ErrInvalidParams, false},
+		{&Error{Code: CodeInvalidParams, Data: `Invalid parameter "/1/name": cannot be empty.`}, ErrInvalidParams, true},
+		{&Error{Code: CodeInvalidRequest, Data: "JSON-rpc version is not specified."}, ErrInvalidParams, false},
+		{&Error{Code: CodeInternalError, Data: "DB error"}, ErrNotFound, false},
+		// ordinary validation errors mentioning permissions are not permission errors
+		{&Error{Code: CodeInvalidParams, Data: `Invalid parameter "/1/permission": value must be one of 0, 2, 3.`}, ErrPermissionDenied, false},
+		{&Error{Code: CodeInvalidParams, Data: "Cannot update user group: insufficient number of users."}, ErrPermissionDenied, false},
+		{&Error{Code: CodeInvalidParams, Data: "Operation cannot be performed on discovered objects."}, ErrPermissionDenied, false},
+		{&Error{Code: CodeInvalidParams, Data: `Invalid parameter "/1/permission": value must be one of 0, 2, 3.`}, ErrInvalidParams, true},
+	}
+	for _, c := range cases {
+		if got := errors.Is(c.err, c.target); got != c.want {
+			t.Errorf("errors.Is(%v, %v) = %v, want %v", c.err, c.target, got, c.want)
+		}
+	}
+
+	one := ExpectedOneResult(2)
+	if !errors.Is(&one, ErrUnexpectedResultCount) || errors.Is(&one, ErrNotFound) {
+		t.Error("unexpected classification of ExpectedOneResult(2)")
+	}
+	if !errors.Is(&ExpectedMore{2, 1}, ErrUnexpectedResultCount) {
+		t.Error("unexpected classification of ExpectedMore")
+	}
+}
@@ -0,0 +1,180 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type (
+	// ConfigurationFormat format of exported or imported configuration data
+	// see "format" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/configuration/export
+	ConfigurationFormat string
+)
+
+const (
+	// ConfigurationYAML YAML format
+	ConfigurationYAML ConfigurationFormat = "yaml"
+	// ConfigurationXML XML format
+	ConfigurationXML ConfigurationFormat = "xml"
+	// ConfigurationJSON JSON format
+	ConfigurationJSON ConfigurationFormat = "json"
+	// ConfigurationRaw unprocessed PHP array, export only
+	ConfigurationRaw ConfigurationFormat = "raw"
+)
+
+// ExportOptions objects to export, by ID
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/configuration/export
+type ExportOptions struct {
+	HostGroups     []string `json:"host_groups,omitempty"`
+	TemplateGroups []string `json:"template_groups,omitempty"`
+	Hosts          []string `json:"hosts,omitempty"`
+	Images         []string `json:"images,omitempty"`
+	Maps           []string `json:"maps,omitempty"`
+	MediaTypes     []string `json:"mediaTypes,omitempty"`
+	Templates      []string `json:"templates,omitempty"`
+}
+
+// ImportRule import behaviour for one object class.
+// Not every flag is supported by every class, see the rules table in the import documentation.
+type ImportRule struct {
+	CreateMissing  bool `json:"createMissing,omitempty"`
+	UpdateExisting bool `json:"updateExisting,omitempty"`
+	DeleteMissing  bool `json:"deleteMissing,omitempty"`
+}
+
+// ImportRules import behaviour per object class
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/configuration/import
+type ImportRules struct {
+	DiscoveryRules     ImportRule `json:"discoveryRules"`
+	Graphs             ImportRule `json:"graphs"`
+	HostGroups         ImportRule `json:"host_groups"`
+	TemplateGroups     ImportRule `json:"template_groups"`
+	Hosts              ImportRule `json:"hosts"`
+	HTTPTests          ImportRule `json:"httptests"`
+	Images             ImportRule `json:"images"`
+	Items              ImportRule `json:"items"`
+	Maps               ImportRule `json:"maps"`
+	MediaTypes         ImportRule `json:"mediaTypes"`
+	TemplateLinkage    ImportRule `json:"templateLinkage"`
+	Templates          ImportRule `json:"templates"`
+	TemplateDashboards ImportRule `json:"templateDashboards"`
+	Triggers           ImportRule `json:"triggers"`
+	ValueMaps          ImportRule `json:"valueMaps"`
+}
+
+// ImportObject an object as described in the import source or the current configuration
+type ImportObject map[string]interface{}
+
+// ImportUpdate an object that would be updated by an import
+type ImportUpdate struct {
+	Before ImportObject
+	After  ImportObject
+	// Changes of nested object classes, like the items of an updated template
+	Changes ImportCompareResult
+}
+
+// UnmarshalJSON splits the before/after pair from the nested object class changes.
+func (u *ImportUpdate) UnmarshalJSON(b []byte) (err error) {
+	var raw map[string]json.RawMessage
+	if err = json.Unmarshal(b, &raw); err != nil {
+		return
+	}
+	*u = ImportUpdate{}
+	for k, v := range raw {
+		switch k {
+		case "before":
+			err = json.Unmarshal(v, &u.Before)
+		case "after":
+			err = json.Unmarshal(v, &u.After)
+		default:
+			var changes ImportChanges
+			if err = json.Unmarshal(v, &changes); err == nil {
+				if u.Changes == nil {
+					u.Changes = ImportCompareResult{}
+				}
+				u.Changes[k] = changes
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshal import update %q: %w", k, err)
+		}
+	}
+	return
+}
+
+// ImportChanges objects of one class that would be added, removed or updated
+type ImportChanges struct {
+	Added   []ImportObject `json:"added,omitempty"`
+	Removed []ImportObject `json:"removed,omitempty"`
+	Updated []ImportUpdate `json:"updated,omitempty"`
+}
+
+// ImportCompareResult changes an import would make, keyed by object class like "templates"
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/configuration/importcompare
+type ImportCompareResult map[string]ImportChanges
+
+// UnmarshalJSON accepts the empty array Zabbix returns when there are no changes.
+func (r *ImportCompareResult) UnmarshalJSON(b []byte) error {
+	if string(b) == "[]" {
+		*r = ImportCompareResult{}
+		return nil
+	}
+	var m map[string]ImportChanges
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	*r = m
+	return nil
+}
+
+// Empty reports whether the import would not change anything.
+func (r ImportCompareResult) Empty() bool {
+	for _, c := range r {
+		if len(c.Added) != 0 || len(c.Removed) != 0 || len(c.Updated) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ConfigurationExport Wrapper for configuration.export
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/configuration/export
+func (api *API) ConfigurationExport(options ExportOptions, format ConfigurationFormat) (res string, err error) {
+	return api.ConfigurationExportContext(context.Background(), options, format)
+}
+
+// ConfigurationExportContext is like ConfigurationExport but uses ctx for the underlying API calls.
+func (api *API) ConfigurationExportContext(ctx context.Context, options ExportOptions, format ConfigurationFormat) (res string, err error) {
+	err = api.CallWithErrorParseContext(ctx, "configuration.export", Params{"options": options, "format": format}, &res)
+	return
+}
+
+// ConfigurationImport Wrapper for configuration.import
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/configuration/import
+func (api *API) ConfigurationImport(source string, format ConfigurationFormat, rules ImportRules) (err error) {
+	return api.ConfigurationImportContext(context.Background(), source, format, rules)
+}
+
+// ConfigurationImportContext is like ConfigurationImport but uses ctx for the underlying API calls.
+func (api *API) ConfigurationImportContext(ctx context.Context, source string, format ConfigurationFormat, rules ImportRules) (err error) {
+	var ok bool
+	err = api.CallWithErrorParseContext(ctx, "configuration.import", Params{"source": source, "format": format, "rules": rules}, &ok)
+	if err == nil && !ok {
+		err = fmt.Errorf("configuration.import returned false")
+	}
+	return
+}
+
+// ConfigurationImportCompare Wrapper for configuration.importcompare
+// Returns the changes ConfigurationImport would make with the same arguments.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/configuration/importcompare
+func (api *API) ConfigurationImportCompare(source string, format ConfigurationFormat, rules ImportRules) (res ImportCompareResult, err error) {
+	return api.ConfigurationImportCompareContext(context.Background(), source, format, rules)
+}
+
+// ConfigurationImportCompareContext is like ConfigurationImportCompare but uses ctx for the underlying API calls.
+func (api *API) ConfigurationImportCompareContext(ctx context.Context, source string, format ConfigurationFormat, rules ImportRules) (res ImportCompareResult, err error) {
+	err = api.CallWithErrorParseContext(ctx, "configuration.importcompare", Params{"source": source, "format": format, "rules": rules}, &res)
+	return
+}
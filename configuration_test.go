@@ -0,0 +1,109 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestConfigurationExport(t *testing.T) {
+	api := getAPI(t)
+
+	group := CreateHostGroup(t)
+	defer DeleteHostGroup(group, t)
+
+	out, err := api.ConfigurationExport(zapi.ExportOptions{HostGroups: []string{group.GroupID}}, zapi.ConfigurationYAML)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, group.Name) {
+		t.Errorf("export does not contain host group %s:\n%s", group.Name, out)
+	}
+
+	res, err := api.ConfigurationImportCompare(out, zapi.ConfigurationYAML, zapi.ImportRules{
+		HostGroups: zapi.ImportRule{CreateMissing: true, UpdateExisting: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Empty() {
+		t.Errorf("re-importing an export should not change anything: %#v", res)
+	}
+}
+
+func TestConfigurationImportCompareFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	var got map[string]interface{}
+	srv.Handle("configuration.importcompare", func(params json.RawMessage) (interface{}, error) {
+		json.Unmarshal(params, &got)
+		return json.RawMessage(`{
+			"templates": {
+				"updated": [{
+					"before": {"template": "Template App", "name": "App"},
+					"after": {"template": "Template App", "name": "App v2"},
+					"items": {
+						"added": [{"key": "app.requests", "name": "Requests"}],
+						"removed": [{"key": "app.legacy"}]
+					}
+				}]
+			}
+		}`), nil
+	})
+
+	rules := zapi.ImportRules{
+		Templates: zapi.ImportRule{CreateMissing: true, UpdateExisting: true},
+		Items:     zapi.ImportRule{CreateMissing: true, UpdateExisting: true, DeleteMissing: true},
+	}
+	res, err := api.ConfigurationImportCompare("zabbix_export: {}", zapi.ConfigurationYAML, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got["format"] != "yaml" || got["source"] != "zabbix_export: {}" {
+		t.Errorf("unexpected params %#v", got)
+	}
+	items := got["rules"].(map[string]interface{})["items"].(map[string]interface{})
+	if items["deleteMissing"] != true {
+		t.Errorf("deleteMissing rule not sent: %#v", items)
+	}
+
+	if res.Empty() {
+		t.Fatal("expected changes")
+	}
+	updated := res["templates"].Updated
+	if len(updated) != 1 || updated[0].After["name"] != "App v2" || updated[0].Before["name"] != "App" {
+		t.Fatalf("unexpected template changes %#v", res["templates"])
+	}
+	nested := updated[0].Changes["items"]
+	if len(nested.Added) != 1 || len(nested.Removed) != 1 || nested.Added[0]["key"] != "app.requests" {
+		t.Errorf("unexpected item changes %#v", nested)
+	}
+
+	srv.Handle("configuration.importcompare", func(params json.RawMessage) (interface{}, error) {
+		return []interface{}{}, nil
+	})
+	res, err = api.ConfigurationImportCompare("zabbix_export: {}", zapi.ConfigurationYAML, rules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Empty() {
+		t.Errorf("expected no changes, got %#v", res)
+	}
+}
+
+func TestConfigurationImportFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	srv.Handle("configuration.import", func(params json.RawMessage) (interface{}, error) {
+		return true, nil
+	})
+	err := api.ConfigurationImport("zabbix_export: {}", zapi.ConfigurationYAML, zapi.ImportRules{
+		Templates: zapi.ImportRule{CreateMissing: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,456 @@
+package zabbix
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type (
+	// DashboardSharingType whether a dashboard is visible to all users
+	// see "private" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/object
+	DashboardSharingType int
+
+	// DashboardPermission access granted to a user or user group a dashboard is shared with
+	// see "permission" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/object#dashboard-user
+	DashboardPermission int
+
+	// WidgetViewMode whether the widget header is shown
+	// see "view_mode" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/object#dashboard-widget
+	WidgetViewMode int
+
+	// WidgetFieldType type of a widget field value
+	// see "type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/object#dashboard-widget-field
+	WidgetFieldType int
+)
+
+const (
+	// DashboardPublic dashboard is visible to all users
+	DashboardPublic DashboardSharingType = 0
+	// DashboardPrivate dashboard is visible to its owner and the users and groups it is shared with
+	DashboardPrivate DashboardSharingType = 1
+)
+
+const (
+	// DashboardReadOnly read-only access
+	DashboardReadOnly DashboardPermission = 2
+	// DashboardReadWrite read-write access
+	DashboardReadWrite DashboardPermission = 3
+)
+
+const (
+	// WidgetViewDefault header is shown
+	WidgetViewDefault WidgetViewMode = 0
+	// WidgetViewHiddenHeader header is hidden
+	WidgetViewHiddenHeader WidgetViewMode = 1
+)
+
+// Widget field value types, the value of reference types is the ID of the object.
+const (
+	WidgetFieldInteger        WidgetFieldType = 0
+	WidgetFieldString         WidgetFieldType = 1
+	WidgetFieldHostGroup      WidgetFieldType = 2
+	WidgetFieldHost           WidgetFieldType = 3
+	WidgetFieldItem           WidgetFieldType = 4
+	WidgetFieldItemPrototype  WidgetFieldType = 5
+	WidgetFieldGraph          WidgetFieldType = 6
+	WidgetFieldGraphPrototype WidgetFieldType = 7
+	WidgetFieldMap            WidgetFieldType = 8
+	WidgetFieldService        WidgetFieldType = 9
+	WidgetFieldSLA            WidgetFieldType = 10
+	WidgetFieldUser           WidgetFieldType = 11
+	WidgetFieldAction         WidgetFieldType = 12
+	WidgetFieldMediaType      WidgetFieldType = 13
+)
+
+// Widget types of DashboardWidget.Type
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/widget_fields
+const (
+	WidgetActionLog          = "actionlog"
+	WidgetClock              = "clock"
+	WidgetDiscovery          = "discovery"
+	WidgetGauge              = "gauge"
+	WidgetGeomap             = "geomap"
+	WidgetGraph              = "graph"
+	WidgetGraphSVG           = "svggraph"
+	WidgetHoneycomb          = "honeycomb"
+	WidgetHostAvailability   = "hostavail"
+	WidgetItem               = "item"
+	WidgetItemHistory        = "itemhistory"
+	WidgetMap                = "map"
+	WidgetPieChart           = "piechart"
+	WidgetPlainText          = "plaintext"
+	WidgetProblemHosts       = "problemhosts"
+	WidgetProblems           = "problems"
+	WidgetProblemsBySeverity = "problemsbysv"
+	WidgetSLAReport          = "slareport"
+	WidgetSystemInfo         = "systeminfo"
+	WidgetTopHosts           = "tophosts"
+	WidgetTriggerOverview    = "trigover"
+	WidgetURL                = "url"
+	WidgetWeb                = "web"
+)
+
+// DashboardWidgetField represent a field of a dashboard widget
+// Fields holding several values are numbered, like "groupids.0" and "groupids.1".
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/object#dashboard-widget-field
+type DashboardWidgetField struct {
+	Type  WidgetFieldType `json:"type,string"`
+	Name  string          `json:"name"`
+	Value string          `json:"value"`
+}
+
+// DashboardWidgetFields is an array of DashboardWidgetField
+type DashboardWidgetFields []DashboardWidgetField
+
+// Values returns the values of the field name, and of its numbered fields in order.
+func (f DashboardWidgetFields) Values(name string) (res []string) {
+	type numbered struct {
+		n     int
+		value string
+	}
+	var list []numbered
+	for _, field := range f {
+		if field.Name == name {
+			res = append(res, field.Value)
+			continue
+		}
+		if !strings.HasPrefix(field.Name, name+".") {
+			continue
+		}
+		if n, err := strconv.Atoi(field.Name[len(name)+1:]); err == nil {
+			list = append(list, numbered{n, field.Value})
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].n < list[j].n })
+	for _, v := range list {
+		res = append(res, v.value)
+	}
+	return
+}
+
+// widgetValues returns the numbered fields name.0, name.1... of type t.
+func widgetValues(t WidgetFieldType, name string, values ...string) DashboardWidgetFields {
+	res := make(DashboardWidgetFields, len(values))
+	for i, v := range values {
+		res[i] = DashboardWidgetField{Type: t, Name: name + "." + strconv.Itoa(i), Value: v}
+	}
+	return res
+}
+
+// widgetInt returns an integer field, or none for the server default 0.
+func widgetInt(name string, v int) DashboardWidgetFields {
+	if v == 0 {
+		return nil
+	}
+	return DashboardWidgetFields{{Type: WidgetFieldInteger, Name: name, Value: strconv.Itoa(v)}}
+}
+
+// WidgetConfig is implemented by the typed widget configurations
+// like GraphWidget and ProblemsWidget used with NewDashboardWidget.
+type WidgetConfig interface {
+	WidgetType() string
+	WidgetFields() DashboardWidgetFields
+}
+
+// GraphWidget classic graph widget showing a graph
+type GraphWidget struct {
+	GraphID string
+}
+
+// WidgetType returns WidgetGraph.
+func (w GraphWidget) WidgetType() string { return WidgetGraph }
+
+// WidgetFields returns the fields of w.
+func (w GraphWidget) WidgetFields() DashboardWidgetFields {
+	return widgetValues(WidgetFieldGraph, "graphid", w.GraphID)
+}
+
+// ItemValueWidget item value widget showing the last value of an item
+type ItemValueWidget struct {
+	ItemID string
+	// Decimals shown for floating point values, zero uses the server default
+	Decimals int
+}
+
+// WidgetType returns WidgetItem.
+func (w ItemValueWidget) WidgetType() string { return WidgetItem }
+
+// WidgetFields returns the fields of w.
+func (w ItemValueWidget) WidgetFields() DashboardWidgetFields {
+	return append(widgetValues(WidgetFieldItem, "itemid", w.ItemID), widgetInt("decimal_places", w.Decimals)...)
+}
+
+// ProblemsWidget problems widget listing current problems
+// Empty filters match all problems.
+type ProblemsWidget struct {
+	HostGroupIDs []string
+	HostIDs      []string
+	Severities   []SeverityType
+	// ShowLines number of problems shown, zero uses the server default
+	ShowLines int
+}
+
+// WidgetType returns WidgetProblems.
+func (w ProblemsWidget) WidgetType() string { return WidgetProblems }
+
+// WidgetFields returns the fields of w.
+func (w ProblemsWidget) WidgetFields() DashboardWidgetFields {
+	severities := make([]string, len(w.Severities))
+	for i, s := range w.Severities {
+		severities[i] = strconv.Itoa(int(s))
+	}
+	res := widgetValues(WidgetFieldHostGroup, "groupids", w.HostGroupIDs...)
+	res = append(res, widgetValues(WidgetFieldHost, "hostids", w.HostIDs...)...)
+	res = append(res, widgetValues(WidgetFieldInteger, "severities", severities...)...)
+	return append(res, widgetInt("show_lines", w.ShowLines)...)
+}
+
+// PlainTextWidget plain text widget showing the latest values of items
+type PlainTextWidget struct {
+	ItemIDs []string
+	// ShowLines number of values shown, zero uses the server default
+	ShowLines int
+}
+
+// WidgetType returns WidgetPlainText.
+func (w PlainTextWidget) WidgetType() string { return WidgetPlainText }
+
+// WidgetFields returns the fields of w.
+func (w PlainTextWidget) WidgetFields() DashboardWidgetFields {
+	return append(widgetValues(WidgetFieldItem, "itemids", w.ItemIDs...), widgetInt("show_lines", w.ShowLines)...)
+}
+
+// SLAReportWidget SLA report widget showing the SLI of an SLA, optionally for one service
+type SLAReportWidget struct {
+	SLAID     string
+	ServiceID string
+	// ShowPeriods number of reporting periods shown, zero uses the server default
+	ShowPeriods int
+}
+
+// WidgetType returns WidgetSLAReport.
+func (w SLAReportWidget) WidgetType() string { return WidgetSLAReport }
+
+// WidgetFields returns the fields of w.
+func (w SLAReportWidget) WidgetFields() DashboardWidgetFields {
+	res := widgetValues(WidgetFieldSLA, "slaid", w.SLAID)
+	if w.ServiceID != "" {
+		res = append(res, widgetValues(WidgetFieldService, "serviceid", w.ServiceID)...)
+	}
+	return append(res, widgetInt("show_periods", w.ShowPeriods)...)
+}
+
+// URLWidget URL widget embedding a web page
+type URLWidget struct {
+	URL string
+}
+
+// WidgetType returns WidgetURL.
+func (w URLWidget) WidgetType() string { return WidgetURL }
+
+// WidgetFields returns the fields of w.
+func (w URLWidget) WidgetFields() DashboardWidgetFields {
+	return DashboardWidgetFields{{Type: WidgetFieldString, Name: "url", Value: w.URL}}
+}
+
+// DashboardWidget represent a widget of a dashboard page
+// Position and size are in grid units: 72 columns wide, rows of 70 pixels.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/object#dashboard-widget
+type DashboardWidget struct {
+	WidgetID string                `json:"widgetid,omitempty"`
+	Type     string                `json:"type"`
+	Name     string                `json:"name,omitempty"`
+	X        int                   `json:"x,string"`
+	Y        int                   `json:"y,string"`
+	Width    int                   `json:"width,string,omitempty"`
+	Height   int                   `json:"height,string,omitempty"`
+	ViewMode WidgetViewMode        `json:"view_mode,string"`
+	Fields   DashboardWidgetFields `json:"fields,omitempty"`
+}
+
+// DashboardWidgets is an array of DashboardWidget
+type DashboardWidgets []DashboardWidget
+
+// NewDashboardWidget returns a widget of the type and fields of cfg placed at x, y.
+func NewDashboardWidget(name string, x, y, width, height int, cfg WidgetConfig) DashboardWidget {
+	return DashboardWidget{
+		Type:   cfg.WidgetType(),
+		Name:   name,
+		X:      x,
+		Y:      y,
+		Width:  width,
+		Height: height,
+		Fields: cfg.WidgetFields(),
+	}
+}
+
+// DashboardPage represent a page of a dashboard
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/object#dashboard-page
+type DashboardPage struct {
+	PageID string `json:"dashboard_pageid,omitempty"`
+	Name   string `json:"name,omitempty"`
+	// DisplayPeriod in seconds in slideshows, zero uses the dashboard default
+	DisplayPeriod int              `json:"display_period,string"`
+	Widgets       DashboardWidgets `json:"widgets"`
+}
+
+// DashboardPages is an array of DashboardPage
+type DashboardPages []DashboardPage
+
+// DashboardUser represent a user a dashboard is shared with
+type DashboardUser struct {
+	UserID     string              `json:"userid"`
+	Permission DashboardPermission `json:"permission,string"`
+}
+
+// DashboardUsers is an array of DashboardUser
+type DashboardUsers []DashboardUser
+
+// DashboardUserGroup represent a user group a dashboard is shared with
+type DashboardUserGroup struct {
+	UserGroupID string              `json:"usrgrpid"`
+	Permission  DashboardPermission `json:"permission,string"`
+}
+
+// DashboardUserGroups is an array of DashboardUserGroup
+type DashboardUserGroups []DashboardUserGroup
+
+// Dashboard represent Zabbix dashboard object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/object
+type Dashboard struct {
+	DashboardID string `json:"dashboardid,omitempty"`
+	Name        string `json:"name"`
+	// UserID of the owner, empty makes the current user the owner
+	UserID  string               `json:"userid,omitempty"`
+	Private DashboardSharingType `json:"private,string"`
+	// DisplayPeriod default page display period in seconds in slideshows, zero uses the server default
+	DisplayPeriod int            `json:"display_period,string,omitempty"`
+	AutoStart     int            `json:"auto_start,string"`
+	Pages         DashboardPages `json:"pages,omitempty"`
+
+	// Sharing, only returned when requested with "selectUsers" and "selectUserGroups"
+	Users      DashboardUsers      `json:"users,omitempty"`
+	UserGroups DashboardUserGroups `json:"userGroups,omitempty"`
+}
+
+// Dashboards is an array of Dashboard
+type Dashboards []Dashboard
+
+// DashboardsGet Wrapper for dashboard.get
+// Selects pages and sharing unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/get
+func (api *API) DashboardsGet(params Params) (res Dashboards, err error) {
+	return api.DashboardsGetContext(context.Background(), params)
+}
+
+// DashboardsGetContext is like DashboardsGet but uses ctx for the underlying API calls.
+func (api *API) DashboardsGetContext(ctx context.Context, params Params) (res Dashboards, err error) {
+	for _, key := range []string{"output", "selectPages", "selectUsers", "selectUserGroups"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "dashboard.get", params, &res)
+	return
+}
+
+// DashboardGetByID Gets dashboard by ID only if there is exactly 1 matching dashboard.
+func (api *API) DashboardGetByID(id string) (res *Dashboard, err error) {
+	return api.DashboardGetByIDContext(context.Background(), id)
+}
+
+// DashboardGetByIDContext is like DashboardGetByID but uses ctx for the underlying API calls.
+func (api *API) DashboardGetByIDContext(ctx context.Context, id string) (res *Dashboard, err error) {
+	dashboards, err := api.DashboardsGetContext(ctx, Params{"dashboardids": id})
+	if err != nil {
+		return
+	}
+
+	if len(dashboards) == 1 {
+		res = &dashboards[0]
+	} else {
+		e := ExpectedOneResult(len(dashboards))
+		err = &e
+	}
+	return
+}
+
+// DashboardsCreate Wrapper for dashboard.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/create
+func (api *API) DashboardsCreate(dashboards Dashboards) (err error) {
+	return api.DashboardsCreateContext(context.Background(), dashboards)
+}
+
+// DashboardsCreateContext is like DashboardsCreate but uses ctx for the underlying API calls.
+func (api *API) DashboardsCreateContext(ctx context.Context, dashboards Dashboards) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "dashboard.create", dashboards)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	dashboardids := result["dashboardids"].([]interface{})
+	for i, id := range dashboardids {
+		dashboards[i].DashboardID = id.(string)
+	}
+	return
+}
+
+// DashboardsUpdate Wrapper for dashboard.update
+// Pages replace the existing ones: pages and widgets without ID are created, missing ones deleted.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/update
+func (api *API) DashboardsUpdate(dashboards Dashboards) (err error) {
+	return api.DashboardsUpdateContext(context.Background(), dashboards)
+}
+
+// DashboardsUpdateContext is like DashboardsUpdate but uses ctx for the underlying API calls.
+func (api *API) DashboardsUpdateContext(ctx context.Context, dashboards Dashboards) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "dashboard.update", dashboards)
+	return
+}
+
+// DashboardsDelete Wrapper for dashboard.delete
+// Cleans DashboardID in all dashboards elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/delete
+func (api *API) DashboardsDelete(dashboards Dashboards) (err error) {
+	return api.DashboardsDeleteContext(context.Background(), dashboards)
+}
+
+// DashboardsDeleteContext is like DashboardsDelete but uses ctx for the underlying API calls.
+func (api *API) DashboardsDeleteContext(ctx context.Context, dashboards Dashboards) (err error) {
+	ids := make([]string, len(dashboards))
+	for i, dashboard := range dashboards {
+		ids[i] = dashboard.DashboardID
+	}
+
+	err = api.DashboardsDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range dashboards {
+			dashboards[i].DashboardID = ""
+		}
+	}
+	return
+}
+
+// DashboardsDeleteByIds Wrapper for dashboard.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dashboard/delete
+func (api *API) DashboardsDeleteByIds(ids []string) (err error) {
+	return api.DashboardsDeleteByIdsContext(context.Background(), ids)
+}
+
+// DashboardsDeleteByIdsContext is like DashboardsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) DashboardsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "dashboard.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	dashboardids := result["dashboardids"].([]interface{})
+	if len(ids) != len(dashboardids) {
+		err = &ExpectedMore{len(ids), len(dashboardids)}
+	}
+	return
+}
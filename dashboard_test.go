@@ -0,0 +1,146 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestDashboardsGet(t *testing.T) {
+	api := getAPI(t)
+
+	dashboards, err := api.DashboardsGet(zapi.Params{})
+	if err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+	if len(dashboards) == 0 {
+		return
+	}
+
+	dashboard, err := api.DashboardGetByID(dashboards[0].DashboardID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dashboard.Pages) == 0 {
+		t.Errorf("pages not selected for dashboard %q", dashboard.Name)
+	}
+}
+
+func TestDashboardsFake(t *testing.T) {
+	api, _ := getFakeAPI(t)
+
+	page := zapi.DashboardPage{Widgets: zapi.DashboardWidgets{
+		zapi.NewDashboardWidget("CPU", 0, 0, 36, 5, zapi.GraphWidget{GraphID: "10"}),
+		zapi.NewDashboardWidget("Problems", 36, 0, 36, 5, zapi.ProblemsWidget{
+			HostGroupIDs: []string{"2", "4"},
+			Severities:   []zapi.SeverityType{zapi.High, zapi.Critical},
+			ShowLines:    10,
+		}),
+		zapi.NewDashboardWidget("SLA", 0, 5, 72, 4, zapi.SLAReportWidget{SLAID: "3"}),
+	}}
+	dashboards := zapi.Dashboards{{
+		Name:       "Weekly",
+		Private:    zapi.DashboardPrivate,
+		Pages:      zapi.DashboardPages{page},
+		Users:      zapi.DashboardUsers{{UserID: "2", Permission: zapi.DashboardReadWrite}},
+		UserGroups: zapi.DashboardUserGroups{{UserGroupID: "7", Permission: zapi.DashboardReadOnly}},
+	}}
+	if err := api.DashboardsCreate(dashboards); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := api.DashboardGetByID(dashboards[0].DashboardID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Private != zapi.DashboardPrivate || len(got.Users) != 1 || len(got.UserGroups) != 1 ||
+		got.UserGroups[0].Permission != zapi.DashboardReadOnly {
+		t.Fatalf("unexpected sharing %#v", got)
+	}
+	if len(got.Pages) != 1 || len(got.Pages[0].Widgets) != 3 {
+		t.Fatalf("unexpected pages %#v", got.Pages)
+	}
+	problems := got.Pages[0].Widgets[1]
+	if problems.Type != zapi.WidgetProblems || problems.X != 36 || problems.Width != 36 {
+		t.Errorf("unexpected widget %#v", problems)
+	}
+	if groups := problems.Fields.Values("groupids"); len(groups) != 2 || groups[1] != "4" {
+		t.Errorf("unexpected groupids %v", groups)
+	}
+	if severities := problems.Fields.Values("severities"); len(severities) != 2 || severities[0] != "4" {
+		t.Errorf("unexpected severities %v", severities)
+	}
+	if lines := problems.Fields.Values("show_lines"); len(lines) != 1 || lines[0] != "10" {
+		t.Errorf("unexpected show_lines %v", lines)
+	}
+
+	reports := zapi.Reports{{
+		UserID:      "1",
+		Name:        "Weekly report",
+		DashboardID: got.DashboardID,
+		Cycle:       zapi.ReportCycleWeekly,
+		Status:      zapi.ReportEnabled,
+		Users:       zapi.ReportUsers{{UserID: "2"}},
+	}}
+	if err := api.ReportsCreate(reports); err != nil {
+		t.Fatal(err)
+	}
+	report, err := api.ReportGetByID(reports[0].ReportID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.DashboardID != got.DashboardID {
+		t.Errorf("unexpected report dashboard %s", report.DashboardID)
+	}
+
+	if err := api.DashboardsCreate(zapi.Dashboards{{Name: "Weekly"}}); err == nil {
+		t.Error("expected duplicate dashboard name to fail")
+	}
+	if err := api.DashboardsDelete(dashboards); err != nil {
+		t.Fatal(err)
+	}
+	if dashboards[0].DashboardID != "" {
+		t.Error("dashboard id was not cleared after delete")
+	}
+}
+
+func TestTemplateDashboardsFake(t *testing.T) {
+	api, _ := getFakeAPI(t)
+
+	widget := zapi.NewDashboardWidget("Load", 0, 0, 24, 5, zapi.ItemValueWidget{ItemID: "30", Decimals: 2})
+	dashboards := zapi.TemplateDashboards{{
+		Name:       "Overview",
+		TemplateID: "10001",
+		Pages:      zapi.DashboardPages{{Widgets: zapi.DashboardWidgets{widget}}},
+	}}
+	if err := api.TemplateDashboardsCreate(dashboards); err != nil {
+		t.Fatal(err)
+	}
+
+	dashboards[0].Name = "Host overview"
+	if err := api.TemplateDashboardsUpdate(dashboards); err != nil {
+		t.Fatal(err)
+	}
+	if dashboards[0].TemplateID != "10001" {
+		t.Error("update changed the caller's template id")
+	}
+
+	got, err := api.TemplateDashboardGetByID(dashboards[0].DashboardID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Host overview" || got.TemplateID != "10001" || len(got.Pages) != 1 {
+		t.Fatalf("unexpected template dashboard %#v", got)
+	}
+	if decimals := got.Pages[0].Widgets[0].Fields.Values("decimal_places"); len(decimals) != 1 || decimals[0] != "2" {
+		t.Errorf("unexpected decimal_places %v", decimals)
+	}
+
+	if err := api.TemplateDashboardsDelete(dashboards); err != nil {
+		t.Fatal(err)
+	}
+	if dashboards[0].DashboardID != "" {
+		t.Error("template dashboard id was not cleared after delete")
+	}
+}
@@ -0,0 +1,390 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+type (
+	// DiscoveryRuleStatusType status of a network discovery rule
+	// see "status" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/drule/object
+	DiscoveryRuleStatusType int
+
+	// DiscoveryCheckType type of a discovery check
+	// see "type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dcheck/object
+	DiscoveryCheckType int
+
+	// DiscoveryHostSourceType source of the host name of discovered hosts
+	// see "host_source" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dcheck/object
+	DiscoveryHostSourceType int
+
+	// DiscoveryNameSourceType source of the visible name of discovered hosts
+	// see "name_source" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dcheck/object
+	DiscoveryNameSourceType int
+)
+
+const (
+	// DiscoveryRuleEnabled rule is enabled
+	DiscoveryRuleEnabled DiscoveryRuleStatusType = 0
+	// DiscoveryRuleDisabled rule is disabled
+	DiscoveryRuleDisabled DiscoveryRuleStatusType = 1
+)
+
+const (
+	// DiscoveryCheckSSH SSH
+	DiscoveryCheckSSH DiscoveryCheckType = 0
+	// DiscoveryCheckLDAP LDAP
+	DiscoveryCheckLDAP DiscoveryCheckType = 1
+	// DiscoveryCheckSMTP SMTP
+	DiscoveryCheckSMTP DiscoveryCheckType = 2
+	// DiscoveryCheckFTP FTP
+	DiscoveryCheckFTP DiscoveryCheckType = 3
+	// DiscoveryCheckHTTP HTTP
+	DiscoveryCheckHTTP DiscoveryCheckType = 4
+	// DiscoveryCheckPOP POP
+	DiscoveryCheckPOP DiscoveryCheckType = 5
+	// DiscoveryCheckNNTP NNTP
+	DiscoveryCheckNNTP DiscoveryCheckType = 6
+	// DiscoveryCheckIMAP IMAP
+	DiscoveryCheckIMAP DiscoveryCheckType = 7
+	// DiscoveryCheckTCP TCP
+	DiscoveryCheckTCP DiscoveryCheckType = 8
+	// DiscoveryCheckZabbixAgent Zabbix agent
+	DiscoveryCheckZabbixAgent DiscoveryCheckType = 9
+	// DiscoveryCheckSNMPv1 SNMPv1 agent
+	DiscoveryCheckSNMPv1 DiscoveryCheckType = 10
+	// DiscoveryCheckSNMPv2c SNMPv2c agent
+	DiscoveryCheckSNMPv2c DiscoveryCheckType = 11
+	// DiscoveryCheckICMP ICMP ping
+	DiscoveryCheckICMP DiscoveryCheckType = 12
+	// DiscoveryCheckSNMPv3 SNMPv3 agent
+	DiscoveryCheckSNMPv3 DiscoveryCheckType = 13
+	// DiscoveryCheckHTTPS HTTPS
+	DiscoveryCheckHTTPS DiscoveryCheckType = 14
+	// DiscoveryCheckTelnet Telnet
+	DiscoveryCheckTelnet DiscoveryCheckType = 15
+)
+
+const (
+	// DiscoveryHostSourceDNS DNS name (default)
+	DiscoveryHostSourceDNS DiscoveryHostSourceType = 1
+	// DiscoveryHostSourceIP IP address
+	DiscoveryHostSourceIP DiscoveryHostSourceType = 2
+	// DiscoveryHostSourceCheck value returned by the check
+	DiscoveryHostSourceCheck DiscoveryHostSourceType = 3
+)
+
+const (
+	// DiscoveryNameSourceNone not specified (default)
+	DiscoveryNameSourceNone DiscoveryNameSourceType = 0
+	// DiscoveryNameSourceDNS DNS name
+	DiscoveryNameSourceDNS DiscoveryNameSourceType = 1
+	// DiscoveryNameSourceIP IP address
+	DiscoveryNameSourceIP DiscoveryNameSourceType = 2
+	// DiscoveryNameSourceCheck value returned by the check
+	DiscoveryNameSourceCheck DiscoveryNameSourceType = 3
+)
+
+// DiscoveryCheck represent Zabbix discovery check object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dcheck/object
+type DiscoveryCheck struct {
+	DCheckID string             `json:"dcheckid,omitempty"`
+	DRuleID  string             `json:"druleid,omitempty"`
+	Type     DiscoveryCheckType `json:"type,string"`
+	// Ports one or more port ranges, like "22,8000-8080"
+	Ports string `json:"ports,omitempty"`
+	// Key item key of agent checks, OID of SNMP checks
+	Key string `json:"key_,omitempty"`
+	// Uniq "1" makes the check the device uniqueness criteria
+	Uniq          string                  `json:"uniq,omitempty"`
+	HostSource    DiscoveryHostSourceType `json:"host_source,omitempty,string"`
+	NameSource    DiscoveryNameSourceType `json:"name_source,omitempty,string"`
+	AllowRedirect string                  `json:"allow_redirect,omitempty"`
+
+	SNMPCommunity        string `json:"snmp_community,omitempty"`
+	SNMPv3AuthPassphrase string `json:"snmpv3_authpassphrase,omitempty"`
+	SNMPv3AuthProtocol   string `json:"snmpv3_authprotocol,omitempty"`
+	SNMPv3ContextName    string `json:"snmpv3_contextname,omitempty"`
+	SNMPv3PrivPassphrase string `json:"snmpv3_privpassphrase,omitempty"`
+	SNMPv3PrivProtocol   string `json:"snmpv3_privprotocol,omitempty"`
+	SNMPv3SecurityLevel  string `json:"snmpv3_securitylevel,omitempty"`
+	SNMPv3SecurityName   string `json:"snmpv3_securityname,omitempty"`
+}
+
+// DiscoveryChecks is an array of DiscoveryCheck
+type DiscoveryChecks []DiscoveryCheck
+
+// DiscoveryRule represent Zabbix network discovery rule object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/drule/object
+type DiscoveryRule struct {
+	DRuleID string `json:"druleid,omitempty"`
+	Name    string `json:"name"`
+	// IPRange checked with ValidateIPRange before create and update
+	IPRange        string                  `json:"iprange"`
+	Delay          string                  `json:"delay,omitempty"`
+	ProxyID        string                  `json:"proxyid,omitempty"`
+	Status         DiscoveryRuleStatusType `json:"status,string"`
+	ConcurrencyMax string                  `json:"concurrency_max,omitempty"`
+
+	DChecks DiscoveryChecks `json:"dchecks,omitempty"`
+
+	// Error of the last discovery run, read only so only decoded
+	Error string `json:"-"`
+}
+
+// UnmarshalJSON decodes the read only error of r.
+func (r *DiscoveryRule) UnmarshalJSON(data []byte) error {
+	type plain DiscoveryRule
+	aux := struct {
+		*plain
+		Error string `json:"error"`
+	}{plain: (*plain)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Error = aux.Error
+	return nil
+}
+
+// DiscoveryRules is an array of DiscoveryRule
+type DiscoveryRules []DiscoveryRule
+
+// ValidateIPRange checks an iprange of a network discovery rule: a comma separated list of
+// IPv4 or IPv6 addresses, CIDR networks (/16 to /30 for IPv4, /112 to /128 for IPv6)
+// and address ranges like "192.168.1-10.1-254" or "fe80::1-ff".
+func ValidateIPRange(r string) error {
+	if strings.TrimSpace(r) == "" {
+		return errors.New("empty IP range")
+	}
+	for _, part := range strings.Split(r, ",") {
+		part = strings.TrimSpace(part)
+		if err := validateIPRangePart(part); err != nil {
+			return fmt.Errorf("invalid IP range %q: %w", part, err)
+		}
+	}
+	return nil
+}
+
+func validateIPRangePart(p string) error {
+	switch {
+	case p == "":
+		return errors.New("empty element")
+	case strings.Contains(p, "/"):
+		ip, network, err := net.ParseCIDR(p)
+		if err != nil {
+			return err
+		}
+		ones, _ := network.Mask.Size()
+		if ip.To4() != nil {
+			if ones < 16 || ones > 30 {
+				return fmt.Errorf("IPv4 prefix /%d outside /16-/30", ones)
+			}
+		} else if ones < 112 || ones > 128 {
+			return fmt.Errorf("IPv6 prefix /%d outside /112-/128", ones)
+		}
+		return nil
+	case net.ParseIP(p) != nil:
+		return nil
+	case strings.Contains(p, ":"):
+		return validateIPv6Range(p)
+	}
+	return validateIPv4Range(p)
+}
+
+func validateIPv4Range(p string) error {
+	octets := strings.Split(p, ".")
+	if len(octets) != 4 {
+		return errors.New("expected 4 octets")
+	}
+	for _, o := range octets {
+		if err := validateRangeBounds(o, 10, 255); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateIPv6Range accepts a range in the last group only, like "fe80::1-ff".
+func validateIPv6Range(p string) error {
+	i := strings.LastIndex(p, ":")
+	last := p[i+1:]
+	if err := validateRangeBounds(last, 16, 0xffff); err != nil {
+		return err
+	}
+	from := strings.SplitN(last, "-", 2)[0]
+	if net.ParseIP(p[:i+1]+from) == nil {
+		return errors.New("malformed IPv6 address")
+	}
+	return nil
+}
+
+// validateRangeBounds checks "n" or "a-b" with a <= b <= max in base.
+func validateRangeBounds(s string, base int, max uint64) error {
+	bounds := strings.Split(s, "-")
+	if len(bounds) > 2 {
+		return fmt.Errorf("malformed range %q", s)
+	}
+	var values []uint64
+	for _, b := range bounds {
+		v, err := strconv.ParseUint(b, base, 64)
+		if err != nil || v > max {
+			return fmt.Errorf("malformed value %q", b)
+		}
+		values = append(values, v)
+	}
+	if len(values) == 2 && values[0] > values[1] {
+		return fmt.Errorf("range %q ends before it starts", s)
+	}
+	return nil
+}
+
+func validateDiscoveryRules(rules DiscoveryRules) error {
+	for _, r := range rules {
+		if err := ValidateIPRange(r.IPRange); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DiscoveryRulesGet Wrapper for drule.get
+// Selects discovery checks unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/drule/get
+func (api *API) DiscoveryRulesGet(params Params) (res DiscoveryRules, err error) {
+	return api.DiscoveryRulesGetContext(context.Background(), params)
+}
+
+// DiscoveryRulesGetContext is like DiscoveryRulesGet but uses ctx for the underlying API calls.
+func (api *API) DiscoveryRulesGetContext(ctx context.Context, params Params) (res DiscoveryRules, err error) {
+	for _, key := range []string{"output", "selectDChecks"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "drule.get", params, &res)
+	return
+}
+
+// DiscoveryRuleGetByID Gets network discovery rule by ID only if there is exactly 1 matching rule.
+func (api *API) DiscoveryRuleGetByID(id string) (res *DiscoveryRule, err error) {
+	return api.DiscoveryRuleGetByIDContext(context.Background(), id)
+}
+
+// DiscoveryRuleGetByIDContext is like DiscoveryRuleGetByID but uses ctx for the underlying API calls.
+func (api *API) DiscoveryRuleGetByIDContext(ctx context.Context, id string) (res *DiscoveryRule, err error) {
+	rules, err := api.DiscoveryRulesGetContext(ctx, Params{"druleids": id})
+	if err != nil {
+		return
+	}
+
+	if len(rules) == 1 {
+		res = &rules[0]
+	} else {
+		e := ExpectedOneResult(len(rules))
+		err = &e
+	}
+	return
+}
+
+// DiscoveryRulesCreate Wrapper for drule.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/drule/create
+func (api *API) DiscoveryRulesCreate(rules DiscoveryRules) (err error) {
+	return api.DiscoveryRulesCreateContext(context.Background(), rules)
+}
+
+// DiscoveryRulesCreateContext is like DiscoveryRulesCreate but uses ctx for the underlying API calls.
+func (api *API) DiscoveryRulesCreateContext(ctx context.Context, rules DiscoveryRules) (err error) {
+	if err = validateDiscoveryRules(rules); err != nil {
+		return
+	}
+	response, err := api.CallWithErrorContext(ctx, "drule.create", rules)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	druleids := result["druleids"].([]interface{})
+	for i, id := range druleids {
+		rules[i].DRuleID = id.(string)
+	}
+	return
+}
+
+// DiscoveryRulesUpdate Wrapper for drule.update
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/drule/update
+func (api *API) DiscoveryRulesUpdate(rules DiscoveryRules) (err error) {
+	return api.DiscoveryRulesUpdateContext(context.Background(), rules)
+}
+
+// DiscoveryRulesUpdateContext is like DiscoveryRulesUpdate but uses ctx for the underlying API calls.
+func (api *API) DiscoveryRulesUpdateContext(ctx context.Context, rules DiscoveryRules) (err error) {
+	if err = validateDiscoveryRules(rules); err != nil {
+		return
+	}
+	_, err = api.CallWithErrorContext(ctx, "drule.update", rules)
+	return
+}
+
+// DiscoveryRulesDelete Wrapper for drule.delete
+// Cleans DRuleID in all rules elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/drule/delete
+func (api *API) DiscoveryRulesDelete(rules DiscoveryRules) (err error) {
+	return api.DiscoveryRulesDeleteContext(context.Background(), rules)
+}
+
+// DiscoveryRulesDeleteContext is like DiscoveryRulesDelete but uses ctx for the underlying API calls.
+func (api *API) DiscoveryRulesDeleteContext(ctx context.Context, rules DiscoveryRules) (err error) {
+	ids := make([]string, len(rules))
+	for i, rule := range rules {
+		ids[i] = rule.DRuleID
+	}
+
+	err = api.DiscoveryRulesDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range rules {
+			rules[i].DRuleID = ""
+		}
+	}
+	return
+}
+
+// DiscoveryRulesDeleteByIds Wrapper for drule.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/drule/delete
+func (api *API) DiscoveryRulesDeleteByIds(ids []string) (err error) {
+	return api.DiscoveryRulesDeleteByIdsContext(context.Background(), ids)
+}
+
+// DiscoveryRulesDeleteByIdsContext is like DiscoveryRulesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) DiscoveryRulesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "drule.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	druleids := result["druleids"].([]interface{})
+	if len(ids) != len(druleids) {
+		err = &ExpectedMore{len(ids), len(druleids)}
+	}
+	return
+}
+
+// DiscoveryChecksGet Wrapper for dcheck.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/dcheck/get
+func (api *API) DiscoveryChecksGet(params Params) (res DiscoveryChecks, err error) {
+	return api.DiscoveryChecksGetContext(context.Background(), params)
+}
+
+// DiscoveryChecksGetContext is like DiscoveryChecksGet but uses ctx for the underlying API calls.
+func (api *API) DiscoveryChecksGetContext(ctx context.Context, params Params) (res DiscoveryChecks, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParseContext(ctx, "dcheck.get", params, &res)
+	return
+}
@@ -0,0 +1,123 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestDiscoveryRulesGet(t *testing.T) {
+	api := getAPI(t)
+
+	if _, err := api.DiscoveryRulesGet(zapi.Params{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.DiscoveryChecksGet(zapi.Params{"limit": 10}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscoveryRulesFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	rules := zapi.DiscoveryRules{{
+		Name:    "Datacenter",
+		IPRange: "10.0.0.0/24, 10.0.1.1-254",
+		Delay:   "1h",
+		DChecks: zapi.DiscoveryChecks{
+			{Type: zapi.DiscoveryCheckICMP},
+			{Type: zapi.DiscoveryCheckZabbixAgent, Ports: "10050", Key: "system.uname", Uniq: "1", HostSource: zapi.DiscoveryHostSourceCheck},
+			{Type: zapi.DiscoveryCheckSNMPv3, Ports: "161", Key: "1.3.6.1.2.1.1.5.0", SNMPv3SecurityName: "monitor", SNMPv3SecurityLevel: "2"},
+		},
+	}}
+	if err := api.DiscoveryRulesCreate(rules); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := api.DiscoveryRuleGetByID(rules[0].DRuleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.DChecks) != 3 || got.DChecks[1].Uniq != "1" || got.DChecks[1].HostSource != zapi.DiscoveryHostSourceCheck || got.DChecks[2].Type != zapi.DiscoveryCheckSNMPv3 {
+		t.Errorf("unexpected checks %#v", got.DChecks)
+	}
+
+	got.IPRange = "10.0.0.300"
+	if err = api.DiscoveryRulesUpdate(zapi.DiscoveryRules{*got}); err == nil {
+		t.Fatal("expected malformed IP range to be rejected")
+	}
+
+	srv.Handle("dcheck.get", func(params json.RawMessage) (interface{}, error) {
+		return []map[string]string{{"dcheckid": "5", "druleid": rules[0].DRuleID, "type": "12"}}, nil
+	})
+	checks, err := api.DiscoveryChecksGet(zapi.Params{"druleids": rules[0].DRuleID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checks) != 1 || checks[0].Type != zapi.DiscoveryCheckICMP {
+		t.Errorf("unexpected checks %#v", checks)
+	}
+
+	if err = api.DiscoveryRulesDelete(rules); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDiscoveryRuleError(t *testing.T) {
+	var rule zapi.DiscoveryRule
+	data := `{"druleid":"2","name":"LAN","iprange":"10.0.0.0/24","status":"0","error":"Cannot resolve proxy."}`
+	if err := json.Unmarshal([]byte(data), &rule); err != nil {
+		t.Fatal(err)
+	}
+	if rule.Error != "Cannot resolve proxy." || rule.IPRange != "10.0.0.0/24" {
+		t.Fatalf("unexpected rule %#v", rule)
+	}
+
+	// the read only error is not sent back on update
+	b, err := json.Marshal(rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), `"error"`) {
+		t.Errorf("error sent in %s", b)
+	}
+}
+
+func TestValidateIPRange(t *testing.T) {
+	valid := []string{
+		"192.168.1.1",
+		"192.168.1.1-255",
+		"192.168.1-10.1-254",
+		"192.168.0.0/24",
+		"10.0.0.0/16,10.1.0.1-10",
+		"fe80::1",
+		"fe80::1-ff",
+		"2001:db8::/112",
+	}
+	for _, r := range valid {
+		if err := zapi.ValidateIPRange(r); err != nil {
+			t.Errorf("%q rejected: %v", r, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"192.168.1",
+		"192.168.1.256",
+		"192.168.1.10-5",
+		"192.168.1.1-2-3",
+		"192.168.0.0/8",
+		"192.168.0.0/31",
+		"2001:db8::/64",
+		"fe80::1-fffff",
+		"10.0.0.1,",
+		"example.com",
+	}
+	for _, r := range invalid {
+		if err := zapi.ValidateIPRange(r); err == nil {
+			t.Errorf("%q accepted", r)
+		}
+	}
+}
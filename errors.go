@@ -0,0 +1,110 @@
+package zabbix
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors matched with errors.Is against errors returned by API methods.
+//
+//	if errors.Is(err, zabbix.ErrAlreadyExists) {
+//		...
+//	}
+var (
+	// ErrNotFound the referred object does not exist. Zabbix reports missing objects and objects
+	// the user may not see with the same error, so such errors match ErrPermissionDenied too.
+	ErrNotFound = errors.New("zabbix: object not found")
+	// ErrPermissionDenied the user is not allowed to perform the operation
+	ErrPermissionDenied = errors.New("zabbix: permission denied")
+	// ErrAlreadyExists an object with the same unique name already exists
+	ErrAlreadyExists = errors.New("zabbix: object already exists")
+	// ErrInvalidParams the request was rejected with CodeInvalidParams for another reason
+	// than the other sentinels: an error matching ErrNotFound, ErrPermissionDenied,
+	// ErrAlreadyExists or ErrSessionExpired does not match ErrInvalidParams.
+	ErrInvalidParams = errors.New("zabbix: invalid params")
+	// ErrSessionExpired the session or API token is not valid anymore
+	ErrSessionExpired = errors.New("zabbix: session expired")
+	// ErrTransport the request did not get a JSON-RPC response
+	ErrTransport = errors.New("zabbix: transport failure")
+	// ErrUnexpectedResultCount a wrapper got a different number of objects than expected
+	ErrUnexpectedResultCount = errors.New("zabbix: unexpected result count")
+)
+
+// JSON-RPC error codes returned by Zabbix.
+const (
+	CodeInvalidRequest = -32600
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeApplication    = -32500
+)
+
+// errorPhrases maps sentinels to lowercase fragments of Error.Data identifying them.
+var errorPhrases = []struct {
+	target  error
+	phrases []string
+}{
+	{ErrNotFound, []string{"does not exist", "no permissions to referred object"}},
+	{ErrPermissionDenied, []string{"no permissions to referred object or it does not exist!",
+		"you do not have permission to perform this operation"}},
+	{ErrAlreadyExists, []string{"already exists"}},
+	{ErrSessionExpired, []string{"session terminated", "re-login", "not authorized", "api token expired",
+		"session expired"}},
+}
+
+// Is classifies e for errors.Is using its code and data.
+func (e *Error) Is(target error) bool {
+	if target == ErrInvalidParams {
+		return e.Code == CodeInvalidParams && !e.hasPhrase(nil)
+	}
+	return e.hasPhrase(target)
+}
+
+// hasPhrase reports whether e contains a phrase of target, or of any sentinel when target is nil.
+func (e *Error) hasPhrase(target error) bool {
+	data := strings.ToLower(e.Data + " " + e.Message)
+	for _, p := range errorPhrases {
+		if target != nil && p.target != target {
+			continue
+		}
+		for _, phrase := range p.phrases {
+			if strings.Contains(data, phrase) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Is matches ErrUnexpectedResultCount, and ErrNotFound when there was no result.
+func (e *ExpectedOneResult) Is(target error) bool {
+	return target == ErrUnexpectedResultCount || (target == ErrNotFound && *e == 0)
+}
+
+// Is matches ErrUnexpectedResultCount.
+func (e *ExpectedMore) Is(target error) bool {
+	return target == ErrUnexpectedResultCount
+}
+
+// TransportError is returned when a request did not get a JSON-RPC response:
+// network failures, cancelled contexts and malformed response bodies.
+// It matches ErrTransport and unwraps to the underlying error.
+type TransportError struct {
+	Method    string
+	RequestID int32
+	Err       error
+}
+
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("%s (request %d): %s", e.Method, e.RequestID, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches ErrTransport.
+func (e *TransportError) Is(target error) bool {
+	return target == ErrTransport
+}
@@ -0,0 +1,58 @@
+package zabbix_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestErrorsFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	groupIDs := srv.Add("hostgroup", map[string]interface{}{"name": "Linux servers"})
+	hosts := zapi.Hosts{{Host: "web01", HostGroupIds: zapi.HostGroupIDs{{GroupID: groupIDs[0]}}}}
+	if err := api.HostsCreate(hosts); err != nil {
+		t.Fatal(err)
+	}
+
+	err := api.HostsCreate(zapi.Hosts{{Host: "web01", HostGroupIds: zapi.HostGroupIDs{{GroupID: groupIDs[0]}}}})
+	if !errors.Is(err, zapi.ErrAlreadyExists) || errors.Is(err, zapi.ErrInvalidParams) {
+		t.Errorf("duplicate host: unexpected error %v", err)
+	}
+	var apiErr *zapi.Error
+	if !errors.As(err, &apiErr) || apiErr.Method != "host.create" || apiErr.RequestID == 0 {
+		t.Errorf("method and request ID not set: %#v", apiErr)
+	}
+	if !strings.Contains(err.Error(), "host.create") {
+		t.Errorf("error %q does not mention the method", err)
+	}
+	if errors.Is(err, zapi.ErrNotFound) || errors.Is(err, zapi.ErrTransport) {
+		t.Errorf("duplicate host misclassified: %v", err)
+	}
+
+	err = api.HostsUpdate(zapi.Hosts{{HostID: "999999", Host: "missing"}})
+	if !errors.Is(err, zapi.ErrNotFound) || !errors.Is(err, zapi.ErrPermissionDenied) {
+		t.Errorf("missing host: unexpected error %v", err)
+	}
+
+	_, err = api.HostGetByID("999999")
+	if !errors.Is(err, zapi.ErrUnexpectedResultCount) || !errors.Is(err, zapi.ErrNotFound) {
+		t.Errorf("get by missing ID: unexpected error %v", err)
+	}
+
+	api.SetAuthenticator(nil) // report the expiry instead of logging in again
+	srv.ExpireSessions()
+	_, err = api.HostsGet(zapi.Params{})
+	if !errors.Is(err, zapi.ErrSessionExpired) {
+		t.Errorf("expired session: unexpected error %v", err)
+	}
+
+	srv.Close()
+	_, err = api.HostsGet(zapi.Params{})
+	var transportErr *zapi.TransportError
+	if !errors.Is(err, zapi.ErrTransport) || !errors.As(err, &transportErr) || transportErr.Method != "host.get" {
+		t.Errorf("closed server: unexpected error %v", err)
+	}
+}
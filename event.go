@@ -0,0 +1,275 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type (
+	// EventSourceType type of the event source
+	// see "source" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/object
+	EventSourceType int
+
+	// EventObjectType type of the object related to the event
+	// see "object" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/object
+	EventObjectType int
+
+	// EventAckActionType bitmask of event update operations
+	// see "action" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/acknowledge
+	EventAckActionType int
+)
+
+const (
+	// EventSourceTrigger event created by a trigger
+	EventSourceTrigger EventSourceType = 0
+	// EventSourceDiscovery event created by a discovery rule
+	EventSourceDiscovery EventSourceType = 1
+	// EventSourceAutoRegistration event created by active agent autoregistration
+	EventSourceAutoRegistration EventSourceType = 2
+	// EventSourceInternal internal event
+	EventSourceInternal EventSourceType = 3
+	// EventSourceService event created on service status update
+	EventSourceService EventSourceType = 4
+)
+
+const (
+	// EventObjectTrigger trigger
+	EventObjectTrigger EventObjectType = 0
+	// EventObjectDiscoveredHost discovered host
+	EventObjectDiscoveredHost EventObjectType = 1
+	// EventObjectDiscoveredService discovered service
+	EventObjectDiscoveredService EventObjectType = 2
+	// EventObjectAutoRegisteredHost auto-registered host
+	EventObjectAutoRegisteredHost EventObjectType = 3
+	// EventObjectItem item
+	EventObjectItem EventObjectType = 4
+	// EventObjectLLDRule LLD rule
+	EventObjectLLDRule EventObjectType = 5
+	// EventObjectService service
+	EventObjectService EventObjectType = 6
+)
+
+const (
+	// EventAckClose close problem
+	EventAckClose EventAckActionType = 1
+	// EventAckAcknowledge acknowledge event
+	EventAckAcknowledge EventAckActionType = 2
+	// EventAckMessage add message
+	EventAckMessage EventAckActionType = 4
+	// EventAckChangeSeverity change severity
+	EventAckChangeSeverity EventAckActionType = 8
+	// EventAckUnacknowledge unacknowledge event
+	EventAckUnacknowledge EventAckActionType = 16
+	// EventAckSuppress suppress event
+	EventAckSuppress EventAckActionType = 32
+	// EventAckUnsuppress unsuppress event
+	EventAckUnsuppress EventAckActionType = 64
+	// EventAckChangeToCause change event to cause
+	EventAckChangeToCause EventAckActionType = 128
+	// EventAckChangeToSymptom change event to symptom
+	EventAckChangeToSymptom EventAckActionType = 256
+)
+
+// EventAcknowledgeEntry represents an update made to an event, as returned by selectAcknowledges
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/object#acknowledges
+type EventAcknowledgeEntry struct {
+	AcknowledgeID string             `json:"acknowledgeid"`
+	UserID        string             `json:"userid"`
+	EventID       string             `json:"eventid,omitempty"`
+	Clock         int64              `json:"clock,string"`
+	Message       string             `json:"message"`
+	Action        EventAckActionType `json:"action,string"`
+	OldSeverity   SeverityType       `json:"old_severity,string"`
+	NewSeverity   SeverityType       `json:"new_severity,string"`
+	SuppressUntil int64              `json:"suppress_until,string"`
+	TaskID        string             `json:"taskid,omitempty"`
+	Username      string             `json:"username,omitempty"`
+	Name          string             `json:"name,omitempty"`
+	Surname       string             `json:"surname,omitempty"`
+}
+
+// EventAcknowledgeEntries is an array of EventAcknowledgeEntry
+type EventAcknowledgeEntries []EventAcknowledgeEntry
+
+// EventSuppression represents a reason an event is suppressed, as returned by selectSuppressionData
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/object#suppression-data
+type EventSuppression struct {
+	MaintenanceID string `json:"maintenanceid"`
+	UserID        string `json:"userid"`
+	SuppressUntil int64  `json:"suppress_until,string"`
+}
+
+// EventSuppressions is an array of EventSuppression
+type EventSuppressions []EventSuppression
+
+// EventURL represents a media type URL attached to an event
+type EventURL struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// ProblemEvent represent Zabbix problem object.
+// Named ProblemEvent because Problem is the trigger value constant.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/problem/object
+type ProblemEvent struct {
+	EventID         string                  `json:"eventid"`
+	Source          EventSourceType         `json:"source,string"`
+	Object          EventObjectType         `json:"object,string"`
+	ObjectID        string                  `json:"objectid"`
+	Clock           int64                   `json:"clock,string"`
+	NS              int                     `json:"ns,string"`
+	REventID        string                  `json:"r_eventid"`
+	RClock          int64                   `json:"r_clock,string"`
+	RNS             int                     `json:"r_ns,string"`
+	CauseEventID    string                  `json:"cause_eventid"`
+	CorrelationID   string                  `json:"correlationid"`
+	UserID          string                  `json:"userid"`
+	Name            string                  `json:"name"`
+	Acknowledged    int                     `json:"acknowledged,string"`
+	Severity        SeverityType            `json:"severity,string"`
+	Suppressed      int                     `json:"suppressed,string"`
+	Opdata          string                  `json:"opdata"`
+	URLs            []EventURL              `json:"urls,omitempty"`
+	Acknowledges    EventAcknowledgeEntries `json:"acknowledges,omitempty"`
+	SuppressionData EventSuppressions       `json:"suppression_data,omitempty"`
+	Tags            Tags                    `json:"tags,omitempty"`
+}
+
+// ProblemEvents is an array of ProblemEvent
+type ProblemEvents []ProblemEvent
+
+// Event represent Zabbix event object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/object
+type Event struct {
+	EventID         string                  `json:"eventid"`
+	Source          EventSourceType         `json:"source,string"`
+	Object          EventObjectType         `json:"object,string"`
+	ObjectID        string                  `json:"objectid"`
+	Acknowledged    int                     `json:"acknowledged,string"`
+	Clock           int64                   `json:"clock,string"`
+	NS              int                     `json:"ns,string"`
+	Name            string                  `json:"name"`
+	Value           int                     `json:"value,string"`
+	Severity        SeverityType            `json:"severity,string"`
+	REventID        string                  `json:"r_eventid"`
+	CEventID        string                  `json:"c_eventid"`
+	CauseEventID    string                  `json:"cause_eventid"`
+	CorrelationID   string                  `json:"correlationid"`
+	UserID          string                  `json:"userid"`
+	Suppressed      int                     `json:"suppressed,string"`
+	Opdata          string                  `json:"opdata"`
+	URLs            []EventURL              `json:"urls,omitempty"`
+	Hosts           Hosts                   `json:"hosts,omitempty"`
+	Acknowledges    EventAcknowledgeEntries `json:"acknowledges,omitempty"`
+	SuppressionData EventSuppressions       `json:"suppression_data,omitempty"`
+	Tags            Tags                    `json:"tags,omitempty"`
+}
+
+// Events is an array of Event
+type Events []Event
+
+// EventAcknowledge parameters of event.acknowledge
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/acknowledge
+type EventAcknowledge struct {
+	EventIDs []string
+	Action   EventAckActionType
+	// Message sent with EventAckMessage
+	Message string
+	// Severity set with EventAckChangeSeverity
+	Severity SeverityType
+	// SuppressUntil Unix time until which the event is suppressed with EventAckSuppress; 0 suppresses indefinitely
+	SuppressUntil int64
+	// CauseEventID cause event for EventAckChangeToSymptom
+	CauseEventID string
+}
+
+// MarshalJSON sends only the fields used by the requested actions.
+func (a EventAcknowledge) MarshalJSON() ([]byte, error) {
+	p := Params{"eventids": a.EventIDs, "action": a.Action}
+	if a.Action&EventAckMessage != 0 {
+		p["message"] = a.Message
+	}
+	if a.Action&EventAckChangeSeverity != 0 {
+		p["severity"] = a.Severity
+	}
+	if a.Action&EventAckSuppress != 0 {
+		p["suppress_until"] = a.SuppressUntil
+	}
+	if a.Action&EventAckChangeToSymptom != 0 {
+		p["cause_eventid"] = a.CauseEventID
+	}
+	return json.Marshal(p)
+}
+
+// ProblemsGet Wrapper for problem.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/problem/get
+func (api *API) ProblemsGet(params Params) (res ProblemEvents, err error) {
+	return api.ProblemsGetContext(context.Background(), params)
+}
+
+// ProblemsGetContext is like ProblemsGet but uses ctx for the underlying API calls.
+func (api *API) ProblemsGetContext(ctx context.Context, params Params) (res ProblemEvents, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParseContext(ctx, "problem.get", params, &res)
+	return
+}
+
+// EventsGet Wrapper for event.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/get
+func (api *API) EventsGet(params Params) (res Events, err error) {
+	return api.EventsGetContext(context.Background(), params)
+}
+
+// EventsGetContext is like EventsGet but uses ctx for the underlying API calls.
+func (api *API) EventsGetContext(ctx context.Context, params Params) (res Events, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParseContext(ctx, "event.get", params, &res)
+	return
+}
+
+// EventGetByID Gets event by ID only if there is exactly 1 matching event.
+func (api *API) EventGetByID(id string) (res *Event, err error) {
+	return api.EventGetByIDContext(context.Background(), id)
+}
+
+// EventGetByIDContext is like EventGetByID but uses ctx for the underlying API calls.
+func (api *API) EventGetByIDContext(ctx context.Context, id string) (res *Event, err error) {
+	events, err := api.EventsGetContext(ctx, Params{"eventids": id})
+	if err != nil {
+		return
+	}
+
+	if len(events) == 1 {
+		res = &events[0]
+	} else {
+		e := ExpectedOneResult(len(events))
+		err = &e
+	}
+	return
+}
+
+// EventsAcknowledge Wrapper for event.acknowledge
+// Returns the IDs of the updated events.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/event/acknowledge
+func (api *API) EventsAcknowledge(ack EventAcknowledge) (eventids []string, err error) {
+	return api.EventsAcknowledgeContext(context.Background(), ack)
+}
+
+// EventsAcknowledgeContext is like EventsAcknowledge but uses ctx for the underlying API calls.
+func (api *API) EventsAcknowledgeContext(ctx context.Context, ack EventAcknowledge) (eventids []string, err error) {
+	var result struct {
+		EventIDs []json.Number `json:"eventids"`
+	}
+	err = api.CallWithErrorParseContext(ctx, "event.acknowledge", ack, &result)
+	if err != nil {
+		return
+	}
+	for _, id := range result.EventIDs {
+		eventids = append(eventids, id.String())
+	}
+	return
+}
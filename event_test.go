@@ -0,0 +1,89 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestProblemsGet(t *testing.T) {
+	api := getAPI(t)
+
+	problems, err := api.ProblemsGet(zapi.Params{"selectAcknowledges": "extend", "selectTags": "extend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) == 0 {
+		return
+	}
+
+	event, err := api.EventGetByID(problems[0].EventID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.EventID != problems[0].EventID {
+		t.Fatalf("unexpected event id: got %s want %s", event.EventID, problems[0].EventID)
+	}
+}
+
+func TestEventsGet(t *testing.T) {
+	api := getAPI(t)
+
+	_, err := api.EventsGet(zapi.Params{"limit": 10, "sortfield": "clock", "sortorder": "DESC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEventsAcknowledgeFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	event := map[string]interface{}{
+		"source": 0, "object": 0, "objectid": "100", "clock": 1700000000, "ns": 0,
+		"name": "High CPU", "severity": 2, "acknowledged": 0, "value": 1,
+		"tags": []interface{}{map[string]interface{}{"tag": "service", "value": "web"}},
+	}
+	ids := srv.Add("event", event)
+	event["eventid"] = ids[0]
+	srv.Add("problem", event)
+
+	problems, err := api.ProblemsGet(zapi.Params{"selectTags": "extend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 1 || problems[0].Severity != zapi.Warning || problems[0].Tags[0].Value != "web" {
+		t.Fatalf("unexpected problems %#v", problems)
+	}
+
+	var sent map[string]interface{}
+	updated, err := api.EventsAcknowledge(zapi.EventAcknowledge{
+		EventIDs: ids,
+		Action:   zapi.EventAckAcknowledge | zapi.EventAckMessage | zapi.EventAckChangeSeverity,
+		Message:  "looking into it",
+		Severity: zapi.NotClassified,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated) != 1 || updated[0] != ids[0] {
+		t.Errorf("unexpected updated ids %v", updated)
+	}
+
+	b, _ := json.Marshal(zapi.EventAcknowledge{EventIDs: ids, Action: zapi.EventAckChangeSeverity, Severity: zapi.NotClassified})
+	json.Unmarshal(b, &sent)
+	if _, ok := sent["severity"]; !ok {
+		t.Errorf("severity omitted for EventAckChangeSeverity: %s", b)
+	}
+	if _, ok := sent["message"]; ok {
+		t.Errorf("message sent without EventAckMessage: %s", b)
+	}
+
+	ev, err := api.EventGetByID(ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ev.Acknowledged != 1 || ev.Severity != zapi.NotClassified {
+		t.Errorf("event not acknowledged: %#v", ev)
+	}
+}
@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 type (
 	GraphType string
 	GraphAxis string
@@ -83,23 +85,37 @@ type Graphs []Graph
 // GraphsGet Wrapper for graph.get
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/graph/get
 func (api *API) GraphsGet(params Params) (res Graphs, err error) {
+	return api.GraphsGetContext(context.Background(), params)
+}
+
+// GraphsGetContext is like GraphsGet but uses ctx for the underlying API calls.
+func (api *API) GraphsGetContext(ctx context.Context, params Params) (res Graphs, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("graph.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "graph.get", params, &res)
 	return
 }
 func (api *API) GraphProtosGet(params Params) (res Graphs, err error) {
+	return api.GraphProtosGetContext(context.Background(), params)
+}
+
+func (api *API) GraphProtosGetContext(ctx context.Context, params Params) (res Graphs, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("graphprototype.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "graphprototype.get", params, &res)
 	return
 }
 
 // GraphGetByID Gets host group by Id only if there is exactly 1 matching host group.
 func (api *API) GraphGetByID(id string) (res *Graph, err error) {
-	groups, err := api.GraphsGet(Params{"graphids": id})
+	return api.GraphGetByIDContext(context.Background(), id)
+}
+
+// GraphGetByIDContext is like GraphGetByID but uses ctx for the underlying API calls.
+func (api *API) GraphGetByIDContext(ctx context.Context, id string) (res *Graph, err error) {
+	groups, err := api.GraphsGetContext(ctx, Params{"graphids": id})
 	if err != nil {
 		return
 	}
@@ -113,7 +129,11 @@ func (api *API) GraphGetByID(id string) (res *Graph, err error) {
 	return
 }
 func (api *API) GraphProtoGetByID(id string) (res *Graph, err error) {
-	groups, err := api.GraphProtosGet(Params{"graphids": id})
+	return api.GraphProtoGetByIDContext(context.Background(), id)
+}
+
+func (api *API) GraphProtoGetByIDContext(ctx context.Context, id string) (res *Graph, err error) {
+	groups, err := api.GraphProtosGetContext(ctx, Params{"graphids": id})
 	if err != nil {
 		return
 	}
@@ -130,7 +150,12 @@ func (api *API) GraphProtoGetByID(id string) (res *Graph, err error) {
 // GraphsCreate Wrapper for graph.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/graph/create
 func (api *API) GraphsCreate(hostGroups Graphs) (err error) {
-	response, err := api.CallWithError("graph.create", hostGroups)
+	return api.GraphsCreateContext(context.Background(), hostGroups)
+}
+
+// GraphsCreateContext is like GraphsCreate but uses ctx for the underlying API calls.
+func (api *API) GraphsCreateContext(ctx context.Context, hostGroups Graphs) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "graph.create", hostGroups)
 	if err != nil {
 		return
 	}
@@ -143,7 +168,11 @@ func (api *API) GraphsCreate(hostGroups Graphs) (err error) {
 	return
 }
 func (api *API) GraphProtosCreate(hostGroups Graphs) (err error) {
-	response, err := api.CallWithError("graphprototype.create", hostGroups)
+	return api.GraphProtosCreateContext(context.Background(), hostGroups)
+}
+
+func (api *API) GraphProtosCreateContext(ctx context.Context, hostGroups Graphs) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "graphprototype.create", hostGroups)
 	if err != nil {
 		return
 	}
@@ -159,11 +188,20 @@ func (api *API) GraphProtosCreate(hostGroups Graphs) (err error) {
 // GraphsUpdate Wrapper for graph.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/graph/update
 func (api *API) GraphsUpdate(hostGroups Graphs) (err error) {
-	_, err = api.CallWithError("graph.update", hostGroups)
+	return api.GraphsUpdateContext(context.Background(), hostGroups)
+}
+
+// GraphsUpdateContext is like GraphsUpdate but uses ctx for the underlying API calls.
+func (api *API) GraphsUpdateContext(ctx context.Context, hostGroups Graphs) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "graph.update", hostGroups)
 	return
 }
 func (api *API) GraphProtosUpdate(hostGroups Graphs) (err error) {
-	_, err = api.CallWithError("graphprototype.update", hostGroups)
+	return api.GraphProtosUpdateContext(context.Background(), hostGroups)
+}
+
+func (api *API) GraphProtosUpdateContext(ctx context.Context, hostGroups Graphs) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "graphprototype.update", hostGroups)
 	return
 }
 
@@ -171,12 +209,17 @@ func (api *API) GraphProtosUpdate(hostGroups Graphs) (err error) {
 // Cleans GroupId in all hostGroups elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/hostgroup/delete
 func (api *API) GraphsDelete(hostGroups Graphs) (err error) {
+	return api.GraphsDeleteContext(context.Background(), hostGroups)
+}
+
+// GraphsDeleteContext is like GraphsDelete but uses ctx for the underlying API calls.
+func (api *API) GraphsDeleteContext(ctx context.Context, hostGroups Graphs) (err error) {
 	ids := make([]string, len(hostGroups))
 	for i, group := range hostGroups {
 		ids[i] = group.GraphID
 	}
 
-	err = api.GraphsDeleteByIds(ids)
+	err = api.GraphsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range hostGroups {
 			hostGroups[i].GraphID = ""
@@ -185,12 +228,16 @@ func (api *API) GraphsDelete(hostGroups Graphs) (err error) {
 	return
 }
 func (api *API) GraphProtosDelete(hostGroups Graphs) (err error) {
+	return api.GraphProtosDeleteContext(context.Background(), hostGroups)
+}
+
+func (api *API) GraphProtosDeleteContext(ctx context.Context, hostGroups Graphs) (err error) {
 	ids := make([]string, len(hostGroups))
 	for i, group := range hostGroups {
 		ids[i] = group.GraphID
 	}
 
-	err = api.GraphProtosDeleteByIds(ids)
+	err = api.GraphProtosDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range hostGroups {
 			hostGroups[i].GraphID = ""
@@ -202,7 +249,12 @@ func (api *API) GraphProtosDelete(hostGroups Graphs) (err error) {
 // HostGroupsDeleteByIds Wrapper for hostgroup.delete
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/hostgroup/delete
 func (api *API) GraphsDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("graph.delete", ids)
+	return api.GraphsDeleteByIdsContext(context.Background(), ids)
+}
+
+// GraphsDeleteByIdsContext is like GraphsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) GraphsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "graph.delete", ids)
 	if err != nil {
 		return
 	}
@@ -215,7 +267,11 @@ func (api *API) GraphsDeleteByIds(ids []string) (err error) {
 	return
 }
 func (api *API) GraphProtosDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("graphprototype.delete", ids)
+	return api.GraphProtosDeleteByIdsContext(context.Background(), ids)
+}
+
+func (api *API) GraphProtosDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "graphprototype.delete", ids)
 	if err != nil {
 		return
 	}
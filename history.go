@@ -0,0 +1,356 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Default time windows used to split large history and trend requests.
+const (
+	DefaultHistoryChunk = 24 * time.Hour
+	DefaultTrendChunk   = 30 * 24 * time.Hour
+)
+
+// HistoryFloat numeric float history value
+type HistoryFloat struct {
+	ItemID string
+	Clock  time.Time
+	Value  float64
+}
+
+// HistoryUnsigned numeric unsigned history value
+type HistoryUnsigned struct {
+	ItemID string
+	Clock  time.Time
+	Value  uint64
+}
+
+// HistoryText character or text history value
+type HistoryText struct {
+	ItemID string
+	Clock  time.Time
+	Value  string
+}
+
+// HistoryLog log history value
+type HistoryLog struct {
+	ItemID     string
+	Clock      time.Time
+	Value      string
+	Timestamp  time.Time
+	Source     string
+	Severity   int
+	LogEventID int
+}
+
+// History values returned by HistoryGet.
+// Only the slice matching ValueType is filled; Character and Text values both go to Texts.
+type History struct {
+	ValueType ValueType
+	Floats    []HistoryFloat
+	Unsigned  []HistoryUnsigned
+	Texts     []HistoryText
+	Logs      []HistoryLog
+}
+
+// Len returns the number of values.
+func (h *History) Len() int {
+	return len(h.Floats) + len(h.Unsigned) + len(h.Texts) + len(h.Logs)
+}
+
+// HistoryQuery parameters of history.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/history/get
+type HistoryQuery struct {
+	// ValueType selects the history table
+	ValueType ValueType
+	ItemIDs   []string
+	HostIDs   []string
+	TimeFrom  time.Time
+	// TimeTill defaults to now when TimeFrom is set
+	TimeTill time.Time
+	// SortOrder SortAsc or SortDesc by clock; empty keeps the server order
+	SortOrder string
+	// Limit maximum number of values over all chunks; 0 is unlimited
+	Limit int
+	// ChunkSize length of the time window requested at once; 0 uses DefaultHistoryChunk,
+	// a negative value sends a single request
+	ChunkSize time.Duration
+}
+
+// Trend hourly aggregate of a numeric item
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/trend/object
+type Trend struct {
+	ItemID string
+	Clock  time.Time
+	Num    int
+	Min    float64
+	Avg    float64
+	Max    float64
+}
+
+// Trends is an array of Trend
+type Trends []Trend
+
+// TrendQuery parameters of trend.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/trend/get
+type TrendQuery struct {
+	ItemIDs  []string
+	TimeFrom time.Time
+	// TimeTill defaults to now when TimeFrom is set
+	TimeTill time.Time
+	// Limit maximum number of trends over all chunks; 0 is unlimited
+	Limit int
+	// ChunkSize length of the time window requested at once; 0 uses DefaultTrendChunk,
+	// a negative value sends a single request
+	ChunkSize time.Duration
+}
+
+type historyRecord struct {
+	ItemID     string `json:"itemid"`
+	Clock      string `json:"clock"`
+	NS         string `json:"ns"`
+	Value      string `json:"value"`
+	Timestamp  string `json:"timestamp"`
+	Source     string `json:"source"`
+	Severity   string `json:"severity"`
+	LogEventID string `json:"logeventid"`
+}
+
+type trendRecord struct {
+	ItemID   string `json:"itemid"`
+	Clock    string `json:"clock"`
+	Num      string `json:"num"`
+	ValueMin string `json:"value_min"`
+	ValueAvg string `json:"value_avg"`
+	ValueMax string `json:"value_max"`
+}
+
+// timeWindow inclusive range of Unix timestamps
+type timeWindow struct {
+	from, till int64
+}
+
+// splitTimeRange splits from..till into windows of chunk, newest first when desc is set.
+// A zero from or a negative chunk yields a single window.
+func splitTimeRange(from, till time.Time, chunk time.Duration, desc bool) []timeWindow {
+	if from.IsZero() {
+		w := timeWindow{}
+		if !till.IsZero() {
+			w.till = till.Unix()
+		}
+		return []timeWindow{w}
+	}
+	if till.IsZero() {
+		till = time.Now()
+	}
+	start, end := from.Unix(), till.Unix()
+	step := int64(chunk / time.Second)
+	if chunk < 0 || step <= 0 {
+		return []timeWindow{{start, end}}
+	}
+
+	var res []timeWindow
+	for s := start; s <= end; s += step {
+		e := s + step - 1
+		if e > end {
+			e = end
+		}
+		res = append(res, timeWindow{s, e})
+	}
+	if desc {
+		for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+			res[i], res[j] = res[j], res[i]
+		}
+	}
+	return res
+}
+
+func unixTime(clock, ns string) (t time.Time, err error) {
+	sec, err := strconv.ParseInt(clock, 10, 64)
+	if err != nil {
+		return
+	}
+	var nsec int64
+	if ns != "" {
+		if nsec, err = strconv.ParseInt(ns, 10, 64); err != nil {
+			return
+		}
+	}
+	t = time.Unix(sec, nsec)
+	return
+}
+
+// appendRecords decodes records into h according to h.ValueType.
+func (h *History) appendRecords(records []historyRecord) error {
+	for _, r := range records {
+		clock, err := unixTime(r.Clock, r.NS)
+		if err != nil {
+			return fmt.Errorf("parse history clock: %w", err)
+		}
+		switch h.ValueType {
+		case Float:
+			v, err := strconv.ParseFloat(r.Value, 64)
+			if err != nil {
+				return fmt.Errorf("parse float history value: %w", err)
+			}
+			h.Floats = append(h.Floats, HistoryFloat{r.ItemID, clock, v})
+		case Unsigned:
+			v, err := strconv.ParseUint(r.Value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("parse unsigned history value: %w", err)
+			}
+			h.Unsigned = append(h.Unsigned, HistoryUnsigned{r.ItemID, clock, v})
+		case Character, Text:
+			h.Texts = append(h.Texts, HistoryText{r.ItemID, clock, r.Value})
+		case Log:
+			l := HistoryLog{ItemID: r.ItemID, Clock: clock, Value: r.Value, Source: r.Source}
+			if r.Timestamp != "" {
+				if l.Timestamp, err = unixTime(r.Timestamp, ""); err != nil {
+					return fmt.Errorf("parse log timestamp: %w", err)
+				}
+			}
+			l.Severity, _ = strconv.Atoi(r.Severity)
+			l.LogEventID, _ = strconv.Atoi(r.LogEventID)
+			h.Logs = append(h.Logs, l)
+		default:
+			return fmt.Errorf("unsupported history value type %d", h.ValueType)
+		}
+	}
+	return nil
+}
+
+// HistoryGet Wrapper for history.get
+// Reads the history table matching q.ValueType and splits the time range into chunks.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/history/get
+func (api *API) HistoryGet(q HistoryQuery) (res History, err error) {
+	return api.HistoryGetContext(context.Background(), q)
+}
+
+// HistoryGetContext is like HistoryGet but uses ctx for the underlying API calls.
+func (api *API) HistoryGetContext(ctx context.Context, q HistoryQuery) (res History, err error) {
+	res.ValueType = q.ValueType
+	chunk := q.ChunkSize
+	if chunk == 0 {
+		chunk = DefaultHistoryChunk
+	}
+
+	for _, w := range splitTimeRange(q.TimeFrom, q.TimeTill, chunk, q.SortOrder == SortDesc) {
+		params := Params{"output": "extend", "history": q.ValueType}
+		if len(q.ItemIDs) > 0 {
+			params["itemids"] = q.ItemIDs
+		}
+		if len(q.HostIDs) > 0 {
+			params["hostids"] = q.HostIDs
+		}
+		if w.from != 0 {
+			params["time_from"] = w.from
+		}
+		if w.till != 0 {
+			params["time_till"] = w.till
+		}
+		if q.SortOrder != "" {
+			params["sortfield"] = "clock"
+			params["sortorder"] = q.SortOrder
+		}
+		if q.Limit > 0 {
+			params["limit"] = q.Limit - res.Len()
+		}
+
+		var records []historyRecord
+		if err = api.CallWithErrorParseContext(ctx, "history.get", params, &records); err != nil {
+			return
+		}
+		if err = res.appendRecords(records); err != nil {
+			return
+		}
+		if q.Limit > 0 && res.Len() >= q.Limit {
+			break
+		}
+	}
+	return
+}
+
+// ItemHistoryGet Gets history of item between from and till from the table matching item.ValueType.
+func (api *API) ItemHistoryGet(item Item, from, till time.Time) (res History, err error) {
+	return api.ItemHistoryGetContext(context.Background(), item, from, till)
+}
+
+// ItemHistoryGetContext is like ItemHistoryGet but uses ctx for the underlying API calls.
+func (api *API) ItemHistoryGetContext(ctx context.Context, item Item, from, till time.Time) (res History, err error) {
+	return api.HistoryGetContext(ctx, HistoryQuery{
+		ValueType: item.ValueType,
+		ItemIDs:   []string{item.ItemID},
+		TimeFrom:  from,
+		TimeTill:  till,
+		SortOrder: SortAsc,
+	})
+}
+
+// TrendsGet Wrapper for trend.get
+// Splits the time range into chunks.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/trend/get
+func (api *API) TrendsGet(q TrendQuery) (res Trends, err error) {
+	return api.TrendsGetContext(context.Background(), q)
+}
+
+// TrendsGetContext is like TrendsGet but uses ctx for the underlying API calls.
+func (api *API) TrendsGetContext(ctx context.Context, q TrendQuery) (res Trends, err error) {
+	chunk := q.ChunkSize
+	if chunk == 0 {
+		chunk = DefaultTrendChunk
+	}
+
+	for _, w := range splitTimeRange(q.TimeFrom, q.TimeTill, chunk, false) {
+		params := Params{"output": "extend"}
+		if len(q.ItemIDs) > 0 {
+			params["itemids"] = q.ItemIDs
+		}
+		if w.from != 0 {
+			params["time_from"] = w.from
+		}
+		if w.till != 0 {
+			params["time_till"] = w.till
+		}
+		if q.Limit > 0 {
+			params["limit"] = q.Limit - len(res)
+		}
+
+		var records []trendRecord
+		if err = api.CallWithErrorParseContext(ctx, "trend.get", params, &records); err != nil {
+			return
+		}
+		for _, r := range records {
+			var t Trend
+			if t, err = r.trend(); err != nil {
+				return
+			}
+			res = append(res, t)
+		}
+		if q.Limit > 0 && len(res) >= q.Limit {
+			break
+		}
+	}
+	return
+}
+
+func (r trendRecord) trend() (t Trend, err error) {
+	t.ItemID = r.ItemID
+	if t.Clock, err = unixTime(r.Clock, ""); err != nil {
+		return t, fmt.Errorf("parse trend clock: %w", err)
+	}
+	if t.Num, err = strconv.Atoi(r.Num); err != nil {
+		return t, fmt.Errorf("parse trend num: %w", err)
+	}
+	if t.Min, err = strconv.ParseFloat(r.ValueMin, 64); err != nil {
+		return t, fmt.Errorf("parse trend value_min: %w", err)
+	}
+	if t.Avg, err = strconv.ParseFloat(r.ValueAvg, 64); err != nil {
+		return t, fmt.Errorf("parse trend value_avg: %w", err)
+	}
+	if t.Max, err = strconv.ParseFloat(r.ValueMax, 64); err != nil {
+		return t, fmt.Errorf("parse trend value_max: %w", err)
+	}
+	return
+}
@@ -0,0 +1,114 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestHistoryGet(t *testing.T) {
+	api := getAPI(t)
+
+	items, err := api.ItemsGet(zapi.Params{"limit": 1, "filter": map[string]interface{}{"value_type": zapi.Unsigned}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	till := time.Now()
+	if _, err = api.ItemHistoryGet(items[0], till.Add(-time.Hour), till); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = api.TrendsGet(zapi.TrendQuery{ItemIDs: []string{items[0].ItemID}, TimeFrom: till.Add(-24 * time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHistoryGetFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	var windows [][2]int64
+	srv.Handle("history.get", func(params json.RawMessage) (interface{}, error) {
+		var p struct {
+			History  int   `json:"history"`
+			TimeFrom int64 `json:"time_from"`
+			TimeTill int64 `json:"time_till"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		windows = append(windows, [2]int64{p.TimeFrom, p.TimeTill})
+		switch p.History {
+		case int(zapi.Float):
+			return []map[string]string{{"itemid": "1", "clock": "1700000000", "ns": "500", "value": "1.5"}}, nil
+		case int(zapi.Log):
+			return []map[string]string{{"itemid": "2", "clock": "1700000000", "ns": "0", "value": "line",
+				"timestamp": "1699999999", "source": "app", "severity": "3", "logeventid": "7"}}, nil
+		}
+		return []interface{}{}, nil
+	})
+
+	from := time.Unix(1700000000, 0)
+	res, err := api.HistoryGet(zapi.HistoryQuery{
+		ValueType: zapi.Float,
+		ItemIDs:   []string{"1"},
+		TimeFrom:  from,
+		TimeTill:  from.Add(3 * time.Hour),
+		ChunkSize: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 4 || windows[0] != [2]int64{1700000000, 1700003599} || windows[3] != [2]int64{1700010800, 1700010800} {
+		t.Errorf("unexpected windows %v", windows)
+	}
+	if len(res.Floats) != 4 || res.Floats[0].Value != 1.5 || !res.Floats[0].Clock.Equal(time.Unix(1700000000, 500)) {
+		t.Errorf("unexpected floats %#v", res.Floats)
+	}
+
+	windows = nil
+	res, err = api.HistoryGet(zapi.HistoryQuery{
+		ValueType: zapi.Log,
+		TimeFrom:  from,
+		TimeTill:  from.Add(3 * time.Hour),
+		ChunkSize: time.Hour,
+		SortOrder: "DESC",
+		Limit:     1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 1 || windows[0][1] != 1700010800 {
+		t.Errorf("expected a single newest window, got %v", windows)
+	}
+	if len(res.Logs) != 1 || res.Logs[0].Severity != 3 || res.Logs[0].LogEventID != 7 || res.Logs[0].Timestamp.Unix() != 1699999999 {
+		t.Errorf("unexpected logs %#v", res.Logs)
+	}
+}
+
+func TestTrendsGetFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	calls := 0
+	srv.Handle("trend.get", func(params json.RawMessage) (interface{}, error) {
+		calls++
+		return []map[string]string{{"itemid": "1", "clock": "1700002800", "num": "60",
+			"value_min": "1", "value_avg": "2.5", "value_max": "4"}}, nil
+	})
+
+	from := time.Unix(1700000000, 0)
+	trends, err := api.TrendsGet(zapi.TrendQuery{ItemIDs: []string{"1"}, TimeFrom: from, TimeTill: from.Add(47 * time.Hour), ChunkSize: 24 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || len(trends) != 2 {
+		t.Fatalf("unexpected calls %d trends %d", calls, len(trends))
+	}
+	if tr := trends[0]; tr.Num != 60 || tr.Min != 1 || tr.Avg != 2.5 || tr.Max != 4 {
+		t.Errorf("unexpected trend %#v", tr)
+	}
+}
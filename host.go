@@ -1,6 +1,7 @@
 package zabbix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -57,13 +58,26 @@ type Host struct {
 // Hosts is an array of Host
 type Hosts []Host
 
+// HostID represent Zabbix HostID
+type HostID struct {
+	HostID string `json:"hostid"`
+}
+
+// HostIDs is an array of HostID
+type HostIDs []HostID
+
 // HostsGet Wrapper for host.get
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/host/get
 func (api *API) HostsGet(params Params) (res Hosts, err error) {
+	return api.HostsGetContext(context.Background(), params)
+}
+
+// HostsGetContext is like HostsGet but uses ctx for the underlying API calls.
+func (api *API) HostsGetContext(ctx context.Context, params Params) (res Hosts, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("host.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "host.get", params, &res)
 
 	// fix up host details if present
 	for i := 0; i < len(res); i++ {
@@ -122,21 +136,36 @@ func (api *API) HostsGet(params Params) (res Hosts, err error) {
 
 // HostsGetByHostGroupIds Gets hosts by host group Ids.
 func (api *API) HostsGetByHostGroupIds(ids []string) (res Hosts, err error) {
-	return api.HostsGet(Params{"groupids": ids})
+	return api.HostsGetByHostGroupIdsContext(context.Background(), ids)
+}
+
+// HostsGetByHostGroupIdsContext is like HostsGetByHostGroupIds but uses ctx for the underlying API calls.
+func (api *API) HostsGetByHostGroupIdsContext(ctx context.Context, ids []string) (res Hosts, err error) {
+	return api.HostsGetContext(ctx, Params{"groupids": ids})
 }
 
 // HostsGetByHostGroups Gets hosts by host groups.
 func (api *API) HostsGetByHostGroups(hostGroups HostGroups) (res Hosts, err error) {
+	return api.HostsGetByHostGroupsContext(context.Background(), hostGroups)
+}
+
+// HostsGetByHostGroupsContext is like HostsGetByHostGroups but uses ctx for the underlying API calls.
+func (api *API) HostsGetByHostGroupsContext(ctx context.Context, hostGroups HostGroups) (res Hosts, err error) {
 	ids := make([]string, len(hostGroups))
 	for i, id := range hostGroups {
 		ids[i] = id.GroupID
 	}
-	return api.HostsGetByHostGroupIds(ids)
+	return api.HostsGetByHostGroupIdsContext(ctx, ids)
 }
 
 // HostGetByID Gets host by Id only if there is exactly 1 matching host.
 func (api *API) HostGetByID(id string) (res *Host, err error) {
-	hosts, err := api.HostsGet(Params{"hostids": id})
+	return api.HostGetByIDContext(context.Background(), id)
+}
+
+// HostGetByIDContext is like HostGetByID but uses ctx for the underlying API calls.
+func (api *API) HostGetByIDContext(ctx context.Context, id string) (res *Host, err error) {
+	hosts, err := api.HostsGetContext(ctx, Params{"hostids": id})
 	if err != nil {
 		return
 	}
@@ -152,7 +181,12 @@ func (api *API) HostGetByID(id string) (res *Host, err error) {
 
 // HostGetByHost Gets host by Host only if there is exactly 1 matching host.
 func (api *API) HostGetByHost(host string) (res *Host, err error) {
-	hosts, err := api.HostsGet(Params{"filter": map[string]string{"host": host}})
+	return api.HostGetByHostContext(context.Background(), host)
+}
+
+// HostGetByHostContext is like HostGetByHost but uses ctx for the underlying API calls.
+func (api *API) HostGetByHostContext(ctx context.Context, host string) (res *Host, err error) {
+	hosts, err := api.HostsGetContext(ctx, Params{"filter": map[string]string{"host": host}})
 	if err != nil {
 		return
 	}
@@ -192,8 +226,13 @@ func prepHosts(hosts Hosts) {
 // HostsCreate Wrapper for host.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/host/create
 func (api *API) HostsCreate(hosts Hosts) (err error) {
+	return api.HostsCreateContext(context.Background(), hosts)
+}
+
+// HostsCreateContext is like HostsCreate but uses ctx for the underlying API calls.
+func (api *API) HostsCreateContext(ctx context.Context, hosts Hosts) (err error) {
 	prepHosts(hosts)
-	response, err := api.CallWithError("host.create", hosts)
+	response, err := api.CallWithErrorContext(ctx, "host.create", hosts)
 	if err != nil {
 		return
 	}
@@ -209,8 +248,13 @@ func (api *API) HostsCreate(hosts Hosts) (err error) {
 // HostsUpdate Wrapper for host.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/host/update
 func (api *API) HostsUpdate(hosts Hosts) (err error) {
+	return api.HostsUpdateContext(context.Background(), hosts)
+}
+
+// HostsUpdateContext is like HostsUpdate but uses ctx for the underlying API calls.
+func (api *API) HostsUpdateContext(ctx context.Context, hosts Hosts) (err error) {
 	prepHosts(hosts)
-	_, err = api.CallWithError("host.update", hosts)
+	_, err = api.CallWithErrorContext(ctx, "host.update", hosts)
 	return
 }
 
@@ -218,12 +262,17 @@ func (api *API) HostsUpdate(hosts Hosts) (err error) {
 // Cleans HostId in all hosts elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/host/delete
 func (api *API) HostsDelete(hosts Hosts) (err error) {
+	return api.HostsDeleteContext(context.Background(), hosts)
+}
+
+// HostsDeleteContext is like HostsDelete but uses ctx for the underlying API calls.
+func (api *API) HostsDeleteContext(ctx context.Context, hosts Hosts) (err error) {
 	ids := make([]string, len(hosts))
 	for i, host := range hosts {
 		ids[i] = host.HostID
 	}
 
-	err = api.HostsDeleteByIds(ids)
+	err = api.HostsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range hosts {
 			hosts[i].HostID = ""
@@ -235,8 +284,13 @@ func (api *API) HostsDelete(hosts Hosts) (err error) {
 // HostsDeleteByIds Wrapper for host.delete
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/host/delete
 func (api *API) HostsDeleteByIds(ids []string) (err error) {
+	return api.HostsDeleteByIdsContext(context.Background(), ids)
+}
+
+// HostsDeleteByIdsContext is like HostsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) HostsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
 	var response Response
-	response, err = api.CallWithError("host.delete", ids)
+	response, err = api.CallWithErrorContext(ctx, "host.delete", ids)
 
 	if err != nil {
 		return
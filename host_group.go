@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 // HostGroup represent Zabbix host group object
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostgroup/object
 type HostGroup struct {
@@ -21,16 +23,26 @@ type HostGroupIDs []HostGroupID
 // HostGroupsGet Wrapper for hostgroup.get
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/hostgroup/get
 func (api *API) HostGroupsGet(params Params) (res HostGroups, err error) {
+	return api.HostGroupsGetContext(context.Background(), params)
+}
+
+// HostGroupsGetContext is like HostGroupsGet but uses ctx for the underlying API calls.
+func (api *API) HostGroupsGetContext(ctx context.Context, params Params) (res HostGroups, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("hostgroup.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "hostgroup.get", params, &res)
 	return
 }
 
 // HostGroupGetByID Gets host group by Id only if there is exactly 1 matching host group.
 func (api *API) HostGroupGetByID(id string) (res *HostGroup, err error) {
-	groups, err := api.HostGroupsGet(Params{"groupids": id})
+	return api.HostGroupGetByIDContext(context.Background(), id)
+}
+
+// HostGroupGetByIDContext is like HostGroupGetByID but uses ctx for the underlying API calls.
+func (api *API) HostGroupGetByIDContext(ctx context.Context, id string) (res *HostGroup, err error) {
+	groups, err := api.HostGroupsGetContext(ctx, Params{"groupids": id})
 	if err != nil {
 		return
 	}
@@ -47,7 +59,12 @@ func (api *API) HostGroupGetByID(id string) (res *HostGroup, err error) {
 // HostGroupsCreate Wrapper for hostgroup.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/hostgroup/create
 func (api *API) HostGroupsCreate(hostGroups HostGroups) (err error) {
-	response, err := api.CallWithError("hostgroup.create", hostGroups)
+	return api.HostGroupsCreateContext(context.Background(), hostGroups)
+}
+
+// HostGroupsCreateContext is like HostGroupsCreate but uses ctx for the underlying API calls.
+func (api *API) HostGroupsCreateContext(ctx context.Context, hostGroups HostGroups) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "hostgroup.create", hostGroups)
 	if err != nil {
 		return
 	}
@@ -63,7 +80,12 @@ func (api *API) HostGroupsCreate(hostGroups HostGroups) (err error) {
 // HostGroupsUpdate Wrapper for hostgroup.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/hostgroup/update
 func (api *API) HostGroupsUpdate(hostGroups HostGroups) (err error) {
-	_, err = api.CallWithError("hostgroup.update", hostGroups)
+	return api.HostGroupsUpdateContext(context.Background(), hostGroups)
+}
+
+// HostGroupsUpdateContext is like HostGroupsUpdate but uses ctx for the underlying API calls.
+func (api *API) HostGroupsUpdateContext(ctx context.Context, hostGroups HostGroups) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "hostgroup.update", hostGroups)
 	return
 }
 
@@ -71,12 +93,17 @@ func (api *API) HostGroupsUpdate(hostGroups HostGroups) (err error) {
 // Cleans GroupId in all hostGroups elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/hostgroup/delete
 func (api *API) HostGroupsDelete(hostGroups HostGroups) (err error) {
+	return api.HostGroupsDeleteContext(context.Background(), hostGroups)
+}
+
+// HostGroupsDeleteContext is like HostGroupsDelete but uses ctx for the underlying API calls.
+func (api *API) HostGroupsDeleteContext(ctx context.Context, hostGroups HostGroups) (err error) {
 	ids := make([]string, len(hostGroups))
 	for i, group := range hostGroups {
 		ids[i] = group.GroupID
 	}
 
-	err = api.HostGroupsDeleteByIds(ids)
+	err = api.HostGroupsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range hostGroups {
 			hostGroups[i].GroupID = ""
@@ -88,7 +115,12 @@ func (api *API) HostGroupsDelete(hostGroups HostGroups) (err error) {
 // HostGroupsDeleteByIds Wrapper for hostgroup.delete
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/hostgroup/delete
 func (api *API) HostGroupsDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("hostgroup.delete", ids)
+	return api.HostGroupsDeleteByIdsContext(context.Background(), ids)
+}
+
+// HostGroupsDeleteByIdsContext is like HostGroupsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) HostGroupsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "hostgroup.delete", ids)
 	if err != nil {
 		return
 	}
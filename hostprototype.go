@@ -0,0 +1,219 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type (
+	// HostPrototypeDiscoverType whether hosts are created from the prototype
+	// see "discover" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/object
+	HostPrototypeDiscoverType int
+
+	// CustomInterfacesType source of the interfaces of discovered hosts
+	// see "custom_interfaces" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/object
+	CustomInterfacesType int
+)
+
+const (
+	// HostPrototypeDiscover discover hosts (default)
+	HostPrototypeDiscover HostPrototypeDiscoverType = 0
+	// HostPrototypeDontDiscover do not discover hosts
+	HostPrototypeDontDiscover HostPrototypeDiscoverType = 1
+)
+
+const (
+	// InterfacesInherit interfaces are inherited from the parent host (default)
+	InterfacesInherit CustomInterfacesType = 0
+	// InterfacesCustom interfaces are taken from Interfaces
+	InterfacesCustom CustomInterfacesType = 1
+)
+
+// HostPrototypeGroupPrototype group created for discovered hosts
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/object#group-prototype
+type HostPrototypeGroupPrototype struct {
+	GroupPrototypeID string `json:"group_prototypeid,omitempty"`
+	// Name may contain LLD macros, like "{#VM.CLUSTER}"
+	Name string `json:"name"`
+}
+
+// HostPrototypeGroupPrototypes is an array of HostPrototypeGroupPrototype
+type HostPrototypeGroupPrototypes []HostPrototypeGroupPrototype
+
+// HostPrototype represent Zabbix host prototype object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/object
+type HostPrototype struct {
+	HostID string     `json:"hostid,omitempty"`
+	Host   string     `json:"host"`
+	Name   string     `json:"name,omitempty"`
+	Status StatusType `json:"status,string"`
+	// RuleID itemid of the parent LLDRule; only sent on create
+	RuleID           string                    `json:"ruleid,omitempty"`
+	InventoryMode    InventoryMode             `json:"inventory_mode,string"`
+	Discover         HostPrototypeDiscoverType `json:"discover,string"`
+	CustomInterfaces CustomInterfacesType      `json:"custom_interfaces,string"`
+
+	GroupLinks      HostGroupIDs                 `json:"groupLinks"`
+	GroupPrototypes HostPrototypeGroupPrototypes `json:"groupPrototypes,omitempty"`
+	TemplateIDs     TemplateIDs                  `json:"templates,omitempty"`
+	Macros          Macros                       `json:"macros,omitempty"`
+	Tags            Tags                         `json:"tags,omitempty"`
+	// Interfaces used when CustomInterfaces is InterfacesCustom
+	Interfaces HostInterfaces `json:"interfaces,omitempty"`
+}
+
+// UnmarshalJSON reads the parent rule returned under "discoveryRule" by hostprototype.get into RuleID.
+func (p *HostPrototype) UnmarshalJSON(data []byte) error {
+	type plain HostPrototype
+	aux := struct {
+		*plain
+		DiscoveryRule *struct {
+			ItemID string `json:"itemid"`
+		} `json:"discoveryRule"`
+	}{plain: (*plain)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.DiscoveryRule != nil {
+		p.RuleID = aux.DiscoveryRule.ItemID
+	}
+	return nil
+}
+
+// HostPrototypes is an array of HostPrototype
+type HostPrototypes []HostPrototype
+
+// HostPrototypesGet Wrapper for hostprototype.get
+// Selects group links, group prototypes, templates, macros, tags, interfaces and the parent rule
+// unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/get
+func (api *API) HostPrototypesGet(params Params) (res HostPrototypes, err error) {
+	return api.HostPrototypesGetContext(context.Background(), params)
+}
+
+// HostPrototypesGetContext is like HostPrototypesGet but uses ctx for the underlying API calls.
+func (api *API) HostPrototypesGetContext(ctx context.Context, params Params) (res HostPrototypes, err error) {
+	for _, key := range []string{"output", "selectGroupLinks", "selectGroupPrototypes", "selectTemplates",
+		"selectMacros", "selectTags", "selectInterfaces", "selectDiscoveryRule"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "hostprototype.get", params, &res)
+	return
+}
+
+// HostPrototypesGetByRuleIds Gets host prototypes of the LLD rules with the given item IDs.
+func (api *API) HostPrototypesGetByRuleIds(ids []string) (res HostPrototypes, err error) {
+	return api.HostPrototypesGetByRuleIdsContext(context.Background(), ids)
+}
+
+// HostPrototypesGetByRuleIdsContext is like HostPrototypesGetByRuleIds but uses ctx for the underlying API calls.
+func (api *API) HostPrototypesGetByRuleIdsContext(ctx context.Context, ids []string) (res HostPrototypes, err error) {
+	return api.HostPrototypesGetContext(ctx, Params{"discoveryids": ids})
+}
+
+// HostPrototypeGetByID Gets host prototype by ID only if there is exactly 1 matching host prototype.
+func (api *API) HostPrototypeGetByID(id string) (res *HostPrototype, err error) {
+	return api.HostPrototypeGetByIDContext(context.Background(), id)
+}
+
+// HostPrototypeGetByIDContext is like HostPrototypeGetByID but uses ctx for the underlying API calls.
+func (api *API) HostPrototypeGetByIDContext(ctx context.Context, id string) (res *HostPrototype, err error) {
+	prototypes, err := api.HostPrototypesGetContext(ctx, Params{"hostids": id})
+	if err != nil {
+		return
+	}
+
+	if len(prototypes) == 1 {
+		res = &prototypes[0]
+	} else {
+		e := ExpectedOneResult(len(prototypes))
+		err = &e
+	}
+	return
+}
+
+// HostPrototypesCreate Wrapper for hostprototype.create
+// Every prototype needs RuleID set to the ItemID of its LLDRule.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/create
+func (api *API) HostPrototypesCreate(prototypes HostPrototypes) (err error) {
+	return api.HostPrototypesCreateContext(context.Background(), prototypes)
+}
+
+// HostPrototypesCreateContext is like HostPrototypesCreate but uses ctx for the underlying API calls.
+func (api *API) HostPrototypesCreateContext(ctx context.Context, prototypes HostPrototypes) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "hostprototype.create", prototypes)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	hostids := result["hostids"].([]interface{})
+	for i, id := range hostids {
+		prototypes[i].HostID = id.(string)
+	}
+	return
+}
+
+// HostPrototypesUpdate Wrapper for hostprototype.update
+// RuleID is not sent, the parent rule of a prototype cannot be changed.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/update
+func (api *API) HostPrototypesUpdate(prototypes HostPrototypes) (err error) {
+	return api.HostPrototypesUpdateContext(context.Background(), prototypes)
+}
+
+// HostPrototypesUpdateContext is like HostPrototypesUpdate but uses ctx for the underlying API calls.
+func (api *API) HostPrototypesUpdateContext(ctx context.Context, prototypes HostPrototypes) (err error) {
+	updates := make(HostPrototypes, len(prototypes))
+	copy(updates, prototypes)
+	for i := range updates {
+		updates[i].RuleID = ""
+	}
+	_, err = api.CallWithErrorContext(ctx, "hostprototype.update", updates)
+	return
+}
+
+// HostPrototypesDelete Wrapper for hostprototype.delete
+// Cleans HostID in all prototypes elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/delete
+func (api *API) HostPrototypesDelete(prototypes HostPrototypes) (err error) {
+	return api.HostPrototypesDeleteContext(context.Background(), prototypes)
+}
+
+// HostPrototypesDeleteContext is like HostPrototypesDelete but uses ctx for the underlying API calls.
+func (api *API) HostPrototypesDeleteContext(ctx context.Context, prototypes HostPrototypes) (err error) {
+	ids := make([]string, len(prototypes))
+	for i, prototype := range prototypes {
+		ids[i] = prototype.HostID
+	}
+
+	err = api.HostPrototypesDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range prototypes {
+			prototypes[i].HostID = ""
+		}
+	}
+	return
+}
+
+// HostPrototypesDeleteByIds Wrapper for hostprototype.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/hostprototype/delete
+func (api *API) HostPrototypesDeleteByIds(ids []string) (err error) {
+	return api.HostPrototypesDeleteByIdsContext(context.Background(), ids)
+}
+
+// HostPrototypesDeleteByIdsContext is like HostPrototypesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) HostPrototypesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "hostprototype.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	hostids := result["hostids"].([]interface{})
+	if len(ids) != len(hostids) {
+		err = &ExpectedMore{len(ids), len(hostids)}
+	}
+	return
+}
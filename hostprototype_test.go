@@ -0,0 +1,86 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestHostPrototypesGet(t *testing.T) {
+	api := getAPI(t)
+
+	if _, err := api.HostPrototypesGet(zapi.Params{"limit": 10}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHostPrototypesFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	hostIDs := srv.Add("host", map[string]interface{}{"host": "vcenter"})
+	groupIDs := srv.Add("hostgroup", map[string]interface{}{"name": "Virtual machines"})
+	templateIDs := srv.Add("template", map[string]interface{}{"host": "VM template"})
+	ruleIDs := srv.Add("discoveryrule",
+		map[string]interface{}{"hostid": hostIDs[0], "key_": "vmware.vm.discovery", "name": "VMs"},
+		map[string]interface{}{"hostid": hostIDs[0], "key_": "docker.containers.discovery", "name": "Containers"},
+	)
+	rule := zapi.LLDRule{ItemID: ruleIDs[0]}
+
+	prototypes := zapi.HostPrototypes{
+		{
+			Host:             "{#VM.UUID}",
+			Name:             "{#VM.NAME}",
+			RuleID:           rule.ItemID,
+			InventoryMode:    zapi.InventoryAutomatic,
+			CustomInterfaces: zapi.InterfacesCustom,
+			GroupLinks:       zapi.HostGroupIDs{{GroupID: groupIDs[0]}},
+			GroupPrototypes:  zapi.HostPrototypeGroupPrototypes{{Name: "Cluster {#VM.CLUSTER}"}},
+			TemplateIDs:      zapi.TemplateIDs{{TemplateID: templateIDs[0]}},
+			Macros:           zapi.Macros{{MacroName: "{$VM.UUID}", Value: "{#VM.UUID}"}},
+			Tags:             zapi.Tags{{Tag: "cluster", Value: "{#VM.CLUSTER}"}},
+			Interfaces: zapi.HostInterfaces{
+				{Type: zapi.Agent, UseIP: "1", IP: "{#VM.IP}", Main: "1", Port: "10050"},
+			},
+		},
+		{
+			Host:       "{#CONTAINER.ID}",
+			RuleID:     ruleIDs[1],
+			GroupLinks: zapi.HostGroupIDs{{GroupID: groupIDs[0]}},
+		},
+	}
+	if err := api.HostPrototypesCreate(prototypes); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := api.HostPrototypesGetByRuleIds([]string{rule.ItemID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].HostID != prototypes[0].HostID {
+		t.Fatalf("unexpected prototypes for rule %s: %#v", rule.ItemID, got)
+	}
+	p := got[0]
+	if p.InventoryMode != zapi.InventoryAutomatic || p.CustomInterfaces != zapi.InterfacesCustom {
+		t.Errorf("unexpected prototype %#v", p)
+	}
+	if len(p.GroupLinks) != 1 || len(p.GroupPrototypes) != 1 || len(p.TemplateIDs) != 1 || len(p.Macros) != 1 || len(p.Tags) != 1 || len(p.Interfaces) != 1 {
+		t.Errorf("nested objects not returned: %#v", p)
+	}
+
+	p.Discover = zapi.HostPrototypeDontDiscover
+	p.RuleID = rule.ItemID
+	if err = api.HostPrototypesUpdate(zapi.HostPrototypes{p}); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := api.HostPrototypeGetByID(p.HostID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Discover != zapi.HostPrototypeDontDiscover {
+		t.Errorf("update not applied: %#v", updated)
+	}
+
+	if err = api.HostPrototypesDelete(prototypes); err != nil {
+		t.Fatal(err)
+	}
+}
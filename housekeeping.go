@@ -0,0 +1,71 @@
+package zabbix
+
+import (
+	"context"
+)
+
+// Housekeeping represent Zabbix housekeeping object
+// Mode fields enable (1) or disable (0) the housekeeping of a data kind, periods
+// are time suffixed like "31d". The global history and trends fields override item settings.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/housekeeping/object
+type Housekeeping struct {
+	EventsMode      int    `json:"hk_events_mode,string"`
+	EventsTrigger   string `json:"hk_events_trigger"`
+	EventsService   string `json:"hk_events_service"`
+	EventsInternal  string `json:"hk_events_internal"`
+	EventsDiscovery string `json:"hk_events_discovery"`
+	EventsAutoreg   string `json:"hk_events_autoreg"`
+
+	ServicesMode int    `json:"hk_services_mode,string"`
+	Services     string `json:"hk_services"`
+	AuditMode    int    `json:"hk_audit_mode,string"`
+	Audit        string `json:"hk_audit"`
+	SessionsMode int    `json:"hk_sessions_mode,string"`
+	Sessions     string `json:"hk_sessions"`
+
+	HistoryMode   int    `json:"hk_history_mode,string"`
+	HistoryGlobal int    `json:"hk_history_global,string"`
+	History       string `json:"hk_history"`
+	TrendsMode    int    `json:"hk_trends_mode,string"`
+	TrendsGlobal  int    `json:"hk_trends_global,string"`
+	Trends        string `json:"hk_trends"`
+
+	CompressionStatus int    `json:"compression_status,string"`
+	CompressOlder     string `json:"compress_older"`
+
+	// Fields below are read only
+	DBExtension             string `json:"db_extension"`
+	CompressionAvailability int    `json:"compression_availability,string"`
+}
+
+// housekeepingReadOnly fields of Housekeeping never sent by HousekeepingUpdate.
+var housekeepingReadOnly = []string{"db_extension", "compression_availability"}
+
+// HousekeepingGet Wrapper for housekeeping.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/housekeeping/get
+func (api *API) HousekeepingGet() (res *Housekeeping, err error) {
+	return api.HousekeepingGetContext(context.Background())
+}
+
+// HousekeepingGetContext is like HousekeepingGet but uses ctx for the underlying API calls.
+func (api *API) HousekeepingGetContext(ctx context.Context) (res *Housekeeping, err error) {
+	res = &Housekeeping{}
+	err = api.CallWithErrorParseContext(ctx, "housekeeping.get", Params{"output": "extend"}, res)
+	if err != nil {
+		res = nil
+	}
+	return
+}
+
+// HousekeepingUpdate Wrapper for housekeeping.update
+// Sends only the fields that differ from the current settings and returns their names,
+// so start from HousekeepingGet rather than the zero value.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/housekeeping/update
+func (api *API) HousekeepingUpdate(housekeeping *Housekeeping) (changed []string, err error) {
+	return api.HousekeepingUpdateContext(context.Background(), housekeeping)
+}
+
+// HousekeepingUpdateContext is like HousekeepingUpdate but uses ctx for the underlying API calls.
+func (api *API) HousekeepingUpdateContext(ctx context.Context, housekeeping *Housekeeping) (changed []string, err error) {
+	return api.updateChanged(ctx, "housekeeping", housekeeping, housekeepingReadOnly, nil)
+}
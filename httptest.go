@@ -0,0 +1,326 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+type (
+	// HTTPTestAuthType HTTP authentication method of a web scenario
+	// see "authentication" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/object
+	HTTPTestAuthType int
+
+	// HTTPTestStatusType status of a web scenario
+	// see "status" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/object
+	HTTPTestStatusType int
+)
+
+const (
+	// HTTPTestAuthNone no authentication
+	HTTPTestAuthNone HTTPTestAuthType = 0
+	// HTTPTestAuthBasic basic authentication
+	HTTPTestAuthBasic HTTPTestAuthType = 1
+	// HTTPTestAuthNTLM NTLM authentication
+	HTTPTestAuthNTLM HTTPTestAuthType = 2
+	// HTTPTestAuthKerberos Kerberos authentication
+	HTTPTestAuthKerberos HTTPTestAuthType = 3
+	// HTTPTestAuthDigest Digest authentication
+	HTTPTestAuthDigest HTTPTestAuthType = 4
+)
+
+const (
+	// HTTPTestEnabled web scenario is enabled
+	HTTPTestEnabled HTTPTestStatusType = 0
+	// HTTPTestDisabled web scenario is disabled
+	HTTPTestDisabled HTTPTestStatusType = 1
+)
+
+// HTTPVariables web scenario or step variables, keyed by name like "{token}"
+type HTTPVariables map[string]string
+
+// HTTPStep represent step of a Zabbix web scenario
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/object#scenario-step
+type HTTPStep struct {
+	HTTPStepID string `json:"httpstepid,omitempty"`
+	Name       string `json:"name"`
+	// No sequence number of the step; filled from the position in Steps when zero
+	No              int    `json:"no,string"`
+	URL             string `json:"url"`
+	FollowRedirects string `json:"follow_redirects,omitempty"`
+	Required        string `json:"required,omitempty"`
+	RetrieveMode    string `json:"retrieve_mode,omitempty"`
+	StatusCodes     string `json:"status_codes,omitempty"`
+	Timeout         string `json:"timeout,omitempty"`
+
+	QueryFields []HttpHeaderEntry `json:"query_fields,omitempty"`
+	// Posts raw post data; ignored when PostFields is set
+	Posts string `json:"-"`
+	// PostFields form fields posted by the step
+	PostFields []HttpHeaderEntry `json:"-"`
+	RawPosts   json.RawMessage   `json:"posts,omitempty"`
+
+	Headers      HttpHeaders     `json:"-"`
+	RawHeaders   json.RawMessage `json:"headers,omitempty"`
+	Variables    HTTPVariables   `json:"-"`
+	RawVariables json.RawMessage `json:"variables,omitempty"`
+}
+
+// HTTPSteps is an array of HTTPStep
+type HTTPSteps []HTTPStep
+
+// HTTPTest represent Zabbix web scenario object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/object
+type HTTPTest struct {
+	HTTPTestID     string             `json:"httptestid,omitempty"`
+	Name           string             `json:"name"`
+	HostID         string             `json:"hostid,omitempty"`
+	Agent          string             `json:"agent,omitempty"`
+	Authentication HTTPTestAuthType   `json:"authentication,string"`
+	HTTPUser       string             `json:"http_user,omitempty"`
+	HTTPPassword   string             `json:"http_password,omitempty"`
+	HTTPProxy      string             `json:"http_proxy,omitempty"`
+	Delay          string             `json:"delay,omitempty"`
+	Retries        int                `json:"retries,omitempty,string"`
+	Status         HTTPTestStatusType `json:"status,string"`
+	VerifyHost     string             `json:"verify_host,omitempty"`
+	VerifyPeer     string             `json:"verify_peer,omitempty"`
+	SSLCertFile    string             `json:"ssl_cert_file,omitempty"`
+	SSLKeyFile     string             `json:"ssl_key_file,omitempty"`
+	SSLKeyPassword string             `json:"ssl_key_password,omitempty"`
+
+	Headers      HttpHeaders     `json:"-"`
+	RawHeaders   json.RawMessage `json:"headers,omitempty"`
+	Variables    HTTPVariables   `json:"-"`
+	RawVariables json.RawMessage `json:"variables,omitempty"`
+
+	Steps HTTPSteps `json:"steps,omitempty"`
+	Tags  Tags      `json:"tags,omitempty"`
+}
+
+// HTTPTests is an array of HTTPTest
+type HTTPTests []HTTPTest
+
+// marshalNameValues encodes m in the array-of-objects format sorted by name.
+func marshalNameValues(m map[string]string) json.RawMessage {
+	entries := make([]HttpHeaderEntry, 0, len(m))
+	for k, v := range m {
+		entries = append(entries, HttpHeaderEntry{Name: k, Value: v})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+	asB, _ := json.Marshal(entries)
+	return json.RawMessage(asB)
+}
+
+// unmarshalNameValues decodes the array-of-objects format into a map.
+func unmarshalNameValues(raw json.RawMessage) (map[string]string, error) {
+	out := map[string]string{}
+	if len(raw) == 0 || string(raw) == "[]" {
+		return out, nil
+	}
+
+	var entries []HttpHeaderEntry
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		out[e.Name] = e.Value
+	}
+	return out, nil
+}
+
+func prepHTTPTests(tests HTTPTests) {
+	for i := range tests {
+		t := &tests[i]
+		if t.Headers != nil {
+			t.RawHeaders = marshalNameValues(t.Headers)
+		}
+		if t.Variables != nil {
+			t.RawVariables = marshalNameValues(t.Variables)
+		}
+
+		for j := range t.Steps {
+			s := &t.Steps[j]
+			if s.No == 0 {
+				s.No = j + 1
+			}
+			if s.Headers != nil {
+				s.RawHeaders = marshalNameValues(s.Headers)
+			}
+			if s.Variables != nil {
+				s.RawVariables = marshalNameValues(s.Variables)
+			}
+			if s.PostFields != nil {
+				asB, _ := json.Marshal(s.PostFields)
+				s.RawPosts = json.RawMessage(asB)
+			} else if s.Posts != "" {
+				asB, _ := json.Marshal(s.Posts)
+				s.RawPosts = json.RawMessage(asB)
+			}
+		}
+	}
+}
+
+func (api *API) httpTestsUnmarshal(tests HTTPTests) (err error) {
+	for i := range tests {
+		t := &tests[i]
+		if t.Headers, err = unmarshalNameValues(t.RawHeaders); err != nil {
+			api.printf("got error during unmarshal %s", err)
+			return fmt.Errorf("unmarshal web scenario headers: %w", err)
+		}
+		var variables map[string]string
+		if variables, err = unmarshalNameValues(t.RawVariables); err != nil {
+			api.printf("got error during unmarshal %s", err)
+			return fmt.Errorf("unmarshal web scenario variables: %w", err)
+		}
+		t.Variables = variables
+
+		for j := range t.Steps {
+			s := &t.Steps[j]
+			if s.Headers, err = unmarshalNameValues(s.RawHeaders); err != nil {
+				api.printf("got error during unmarshal %s", err)
+				return fmt.Errorf("unmarshal web scenario step headers: %w", err)
+			}
+			if variables, err = unmarshalNameValues(s.RawVariables); err != nil {
+				api.printf("got error during unmarshal %s", err)
+				return fmt.Errorf("unmarshal web scenario step variables: %w", err)
+			}
+			s.Variables = variables
+
+			if len(s.RawPosts) == 0 {
+				continue
+			}
+			// posts is a string for raw data and an array for form fields
+			if err = json.Unmarshal(s.RawPosts, &s.Posts); err == nil {
+				continue
+			}
+			if err = json.Unmarshal(s.RawPosts, &s.PostFields); err != nil {
+				api.printf("got error during unmarshal %s", err)
+				return fmt.Errorf("unmarshal web scenario step posts: %w", err)
+			}
+		}
+	}
+	return
+}
+
+// HTTPTestsGet Wrapper for httptest.get
+// Selects steps and tags unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/get
+func (api *API) HTTPTestsGet(params Params) (res HTTPTests, err error) {
+	return api.HTTPTestsGetContext(context.Background(), params)
+}
+
+// HTTPTestsGetContext is like HTTPTestsGet but uses ctx for the underlying API calls.
+func (api *API) HTTPTestsGetContext(ctx context.Context, params Params) (res HTTPTests, err error) {
+	for _, key := range []string{"output", "selectSteps", "selectTags"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	if err = api.CallWithErrorParseContext(ctx, "httptest.get", params, &res); err != nil {
+		return
+	}
+	err = api.httpTestsUnmarshal(res)
+	return
+}
+
+// HTTPTestGetByID Gets web scenario by ID only if there is exactly 1 matching web scenario.
+func (api *API) HTTPTestGetByID(id string) (res *HTTPTest, err error) {
+	return api.HTTPTestGetByIDContext(context.Background(), id)
+}
+
+// HTTPTestGetByIDContext is like HTTPTestGetByID but uses ctx for the underlying API calls.
+func (api *API) HTTPTestGetByIDContext(ctx context.Context, id string) (res *HTTPTest, err error) {
+	tests, err := api.HTTPTestsGetContext(ctx, Params{"httptestids": id})
+	if err != nil {
+		return
+	}
+
+	if len(tests) == 1 {
+		res = &tests[0]
+	} else {
+		e := ExpectedOneResult(len(tests))
+		err = &e
+	}
+	return
+}
+
+// HTTPTestsCreate Wrapper for httptest.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/create
+func (api *API) HTTPTestsCreate(tests HTTPTests) (err error) {
+	return api.HTTPTestsCreateContext(context.Background(), tests)
+}
+
+// HTTPTestsCreateContext is like HTTPTestsCreate but uses ctx for the underlying API calls.
+func (api *API) HTTPTestsCreateContext(ctx context.Context, tests HTTPTests) (err error) {
+	prepHTTPTests(tests)
+	response, err := api.CallWithErrorContext(ctx, "httptest.create", tests)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	httptestids := result["httptestids"].([]interface{})
+	for i, id := range httptestids {
+		tests[i].HTTPTestID = id.(string)
+	}
+	return
+}
+
+// HTTPTestsUpdate Wrapper for httptest.update
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/update
+func (api *API) HTTPTestsUpdate(tests HTTPTests) (err error) {
+	return api.HTTPTestsUpdateContext(context.Background(), tests)
+}
+
+// HTTPTestsUpdateContext is like HTTPTestsUpdate but uses ctx for the underlying API calls.
+func (api *API) HTTPTestsUpdateContext(ctx context.Context, tests HTTPTests) (err error) {
+	prepHTTPTests(tests)
+	_, err = api.CallWithErrorContext(ctx, "httptest.update", tests)
+	return
+}
+
+// HTTPTestsDelete Wrapper for httptest.delete
+// Cleans HTTPTestID in all tests elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/delete
+func (api *API) HTTPTestsDelete(tests HTTPTests) (err error) {
+	return api.HTTPTestsDeleteContext(context.Background(), tests)
+}
+
+// HTTPTestsDeleteContext is like HTTPTestsDelete but uses ctx for the underlying API calls.
+func (api *API) HTTPTestsDeleteContext(ctx context.Context, tests HTTPTests) (err error) {
+	ids := make([]string, len(tests))
+	for i, test := range tests {
+		ids[i] = test.HTTPTestID
+	}
+
+	err = api.HTTPTestsDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range tests {
+			tests[i].HTTPTestID = ""
+		}
+	}
+	return
+}
+
+// HTTPTestsDeleteByIds Wrapper for httptest.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/httptest/delete
+func (api *API) HTTPTestsDeleteByIds(ids []string) (err error) {
+	return api.HTTPTestsDeleteByIdsContext(context.Background(), ids)
+}
+
+// HTTPTestsDeleteByIdsContext is like HTTPTestsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) HTTPTestsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "httptest.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	httptestids := result["httptestids"].([]interface{})
+	if len(ids) != len(httptestids) {
+		err = &ExpectedMore{len(ids), len(httptestids)}
+	}
+	return
+}
@@ -0,0 +1,90 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestHTTPTestsGet(t *testing.T) {
+	api := getAPI(t)
+
+	if _, err := api.HTTPTestsGet(zapi.Params{"limit": 10}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHTTPTestsFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	hostIDs := srv.Add("host", map[string]interface{}{"host": "web01"})
+	tests := zapi.HTTPTests{{
+		Name:           "Login",
+		HostID:         hostIDs[0],
+		Agent:          "Zabbix",
+		Authentication: zapi.HTTPTestAuthBasic,
+		HTTPUser:       "monitor",
+		HTTPPassword:   "secret",
+		Delay:          "1m",
+		Retries:        2,
+		Headers:        zapi.HttpHeaders{"Accept": "text/html"},
+		Variables:      zapi.HTTPVariables{"{base}": "https://example.com"},
+		Tags:           zapi.Tags{{Tag: "team", Value: "web"}},
+		Steps: zapi.HTTPSteps{
+			{
+				Name:            "Front page",
+				URL:             "{base}/",
+				StatusCodes:     "200",
+				Required:        "Welcome",
+				FollowRedirects: "1",
+				Variables:       zapi.HTTPVariables{"{csrf}": "regex:name=\"csrf\" value=\"([^\"]+)\""},
+			},
+			{
+				Name:        "Sign in",
+				URL:         "{base}/login",
+				StatusCodes: "200,302",
+				Headers:     zapi.HttpHeaders{"X-CSRF": "{csrf}"},
+				PostFields:  []zapi.HttpHeaderEntry{{Name: "user", Value: "monitor"}, {Name: "csrf", Value: "{csrf}"}},
+			},
+		},
+	}}
+	if err := api.HTTPTestsCreate(tests); err != nil {
+		t.Fatal(err)
+	}
+	if tests[0].Steps[1].No != 2 {
+		t.Errorf("step number not filled: %d", tests[0].Steps[1].No)
+	}
+
+	got, err := api.HTTPTestGetByID(tests[0].HTTPTestID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Authentication != zapi.HTTPTestAuthBasic || got.Retries != 2 || got.Headers["Accept"] != "text/html" || got.Variables["{base}"] != "https://example.com" {
+		t.Errorf("unexpected web scenario %#v", got)
+	}
+	if len(got.Tags) != 1 || len(got.Steps) != 2 {
+		t.Fatalf("unexpected tags %#v or steps %#v", got.Tags, got.Steps)
+	}
+	if s := got.Steps[0]; s.Required != "Welcome" || s.Variables["{csrf}"] == "" {
+		t.Errorf("unexpected first step %#v", s)
+	}
+	if s := got.Steps[1]; s.Headers["X-CSRF"] != "{csrf}" || len(s.PostFields) != 2 || s.PostFields[1].Name != "csrf" {
+		t.Errorf("unexpected second step %#v", s)
+	}
+
+	got.Steps[1].PostFields = nil
+	got.Steps[1].Posts = `{"user":"monitor"}`
+	if err = api.HTTPTestsUpdate(zapi.HTTPTests{*got}); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = api.HTTPTestGetByID(tests[0].HTTPTestID); err != nil {
+		t.Fatal(err)
+	}
+	if got.Steps[1].Posts != `{"user":"monitor"}` || got.Steps[1].PostFields != nil {
+		t.Errorf("raw posts not round-tripped: %#v", got.Steps[1])
+	}
+
+	if err = api.HTTPTestsDelete(tests); err != nil {
+		t.Fatal(err)
+	}
+}
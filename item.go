@@ -1,6 +1,7 @@
 package zabbix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -132,6 +133,7 @@ type Item struct {
 	Trends       string    `json:"trends,omitempty"`
 	TrapperHosts string    `json:"trapper_hosts,omitempty"`
 	Params       string    `json:"params,omitempty"`
+	ValueMapID   string    `json:"valuemapid,omitempty"`
 
 	ItemParent Hosts `json:"hosts,omitempty"`
 
@@ -174,6 +176,27 @@ type Item struct {
 	DiscoveryRule *LLDRule `json:"discoveryRule,omitempty"`
 
 	Tags Tags `json:"tags,omitempty"`
+
+	// ValueMap is only returned when requested with "selectValueMap", it is never sent
+	ValueMap *ValueMap `json:"-"`
+}
+
+// UnmarshalJSON reads ValueMap from "valuemap", which is an empty array for items without value map.
+func (i *Item) UnmarshalJSON(data []byte) error {
+	type plain Item
+	aux := struct {
+		*plain
+		ValueMap json.RawMessage `json:"valuemap"`
+	}{plain: (*plain)(i)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	i.ValueMap = nil
+	if len(aux.ValueMap) > 0 && aux.ValueMap[0] == '{' {
+		i.ValueMap = &ValueMap{}
+		return json.Unmarshal(aux.ValueMap, i.ValueMap)
+	}
+	return nil
 }
 
 type Preprocessors []Preprocessor
@@ -216,10 +239,15 @@ func (items Items) ByKey() (res map[string]Item) {
 // ItemsGet Wrapper for item.get
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/get
 func (api *API) ItemsGet(params Params) (res Items, err error) {
+	return api.ItemsGetContext(context.Background(), params)
+}
+
+// ItemsGetContext is like ItemsGet but uses ctx for the underlying API calls.
+func (api *API) ItemsGetContext(ctx context.Context, params Params) (res Items, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("item.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "item.get", params, &res)
 	if err != nil {
 		return
 	}
@@ -227,10 +255,14 @@ func (api *API) ItemsGet(params Params) (res Items, err error) {
 	return
 }
 func (api *API) ProtoItemsGet(params Params) (res Items, err error) {
+	return api.ProtoItemsGetContext(context.Background(), params)
+}
+
+func (api *API) ProtoItemsGetContext(ctx context.Context, params Params) (res Items, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("itemprototype.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "itemprototype.get", params, &res)
 	if err != nil {
 		return
 	}
@@ -292,7 +324,12 @@ func prepItems(item Items) {
 
 // ItemGetByID Gets item by Id only if there is exactly 1 matching host.
 func (api *API) ItemGetByID(id string) (res *Item, err error) {
-	items, err := api.ItemsGet(Params{"itemids": id})
+	return api.ItemGetByIDContext(context.Background(), id)
+}
+
+// ItemGetByIDContext is like ItemGetByID but uses ctx for the underlying API calls.
+func (api *API) ItemGetByIDContext(ctx context.Context, id string) (res *Item, err error) {
+	items, err := api.ItemsGetContext(ctx, Params{"itemids": id})
 	if err != nil {
 		return
 	}
@@ -306,7 +343,11 @@ func (api *API) ItemGetByID(id string) (res *Item, err error) {
 	return
 }
 func (api *API) ProtoItemGetByID(id string) (res *Item, err error) {
-	items, err := api.ProtoItemsGet(Params{"itemids": id})
+	return api.ProtoItemGetByIDContext(context.Background(), id)
+}
+
+func (api *API) ProtoItemGetByIDContext(ctx context.Context, id string) (res *Item, err error) {
+	items, err := api.ProtoItemsGetContext(ctx, Params{"itemids": id})
 	if err != nil {
 		return
 	}
@@ -323,8 +364,13 @@ func (api *API) ProtoItemGetByID(id string) (res *Item, err error) {
 // ItemsCreate Wrapper for item.create
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/item/create
 func (api *API) ItemsCreate(items Items) (err error) {
+	return api.ItemsCreateContext(context.Background(), items)
+}
+
+// ItemsCreateContext is like ItemsCreate but uses ctx for the underlying API calls.
+func (api *API) ItemsCreateContext(ctx context.Context, items Items) (err error) {
 	prepItems(items)
-	response, err := api.CallWithError("item.create", items)
+	response, err := api.CallWithErrorContext(ctx, "item.create", items)
 	if err != nil {
 		return
 	}
@@ -337,8 +383,12 @@ func (api *API) ItemsCreate(items Items) (err error) {
 	return
 }
 func (api *API) ProtoItemsCreate(items Items) (err error) {
+	return api.ProtoItemsCreateContext(context.Background(), items)
+}
+
+func (api *API) ProtoItemsCreateContext(ctx context.Context, items Items) (err error) {
 	prepItems(items)
-	response, err := api.CallWithError("itemprototype.create", items)
+	response, err := api.CallWithErrorContext(ctx, "itemprototype.create", items)
 	if err != nil {
 		return
 	}
@@ -354,13 +404,22 @@ func (api *API) ProtoItemsCreate(items Items) (err error) {
 // ItemsUpdate Wrapper for item.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/update
 func (api *API) ItemsUpdate(items Items) (err error) {
+	return api.ItemsUpdateContext(context.Background(), items)
+}
+
+// ItemsUpdateContext is like ItemsUpdate but uses ctx for the underlying API calls.
+func (api *API) ItemsUpdateContext(ctx context.Context, items Items) (err error) {
 	prepItems(items)
-	_, err = api.CallWithError("item.update", items)
+	_, err = api.CallWithErrorContext(ctx, "item.update", items)
 	return
 }
 func (api *API) ProtoItemsUpdate(items Items) (err error) {
+	return api.ProtoItemsUpdateContext(context.Background(), items)
+}
+
+func (api *API) ProtoItemsUpdateContext(ctx context.Context, items Items) (err error) {
 	prepItems(items)
-	_, err = api.CallWithError("itemprototype.update", items)
+	_, err = api.CallWithErrorContext(ctx, "itemprototype.update", items)
 	return
 }
 
@@ -368,12 +427,17 @@ func (api *API) ProtoItemsUpdate(items Items) (err error) {
 // Cleans ItemId in all items elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/delete
 func (api *API) ItemsDelete(items Items) (err error) {
+	return api.ItemsDeleteContext(context.Background(), items)
+}
+
+// ItemsDeleteContext is like ItemsDelete but uses ctx for the underlying API calls.
+func (api *API) ItemsDeleteContext(ctx context.Context, items Items) (err error) {
 	ids := make([]string, len(items))
 	for i, item := range items {
 		ids[i] = item.ItemID
 	}
 
-	err = api.ItemsDeleteByIds(ids)
+	err = api.ItemsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range items {
 			items[i].ItemID = ""
@@ -382,12 +446,16 @@ func (api *API) ItemsDelete(items Items) (err error) {
 	return
 }
 func (api *API) ProtoItemsDelete(items Items) (err error) {
+	return api.ProtoItemsDeleteContext(context.Background(), items)
+}
+
+func (api *API) ProtoItemsDeleteContext(ctx context.Context, items Items) (err error) {
 	ids := make([]string, len(items))
 	for i, item := range items {
 		ids[i] = item.ItemID
 	}
 
-	err = api.ProtoItemsDeleteByIds(ids)
+	err = api.ProtoItemsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range items {
 			items[i].ItemID = ""
@@ -399,7 +467,12 @@ func (api *API) ProtoItemsDelete(items Items) (err error) {
 // ItemsDeleteByIds Wrapper for item.delete
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/delete
 func (api *API) ItemsDeleteByIds(ids []string) (err error) {
-	deleteIds, err := api.ItemsDeleteIDs(ids)
+	return api.ItemsDeleteByIdsContext(context.Background(), ids)
+}
+
+// ItemsDeleteByIdsContext is like ItemsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) ItemsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	deleteIds, err := api.ItemsDeleteIDsContext(ctx, ids)
 	if err != nil {
 		return
 	}
@@ -410,7 +483,11 @@ func (api *API) ItemsDeleteByIds(ids []string) (err error) {
 	return
 }
 func (api *API) ProtoItemsDeleteByIds(ids []string) (err error) {
-	deleteIds, err := api.ProtoItemsDeleteIDs(ids)
+	return api.ProtoItemsDeleteByIdsContext(context.Background(), ids)
+}
+
+func (api *API) ProtoItemsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	deleteIds, err := api.ProtoItemsDeleteIDsContext(ctx, ids)
 	if err != nil {
 		return
 	}
@@ -424,7 +501,12 @@ func (api *API) ProtoItemsDeleteByIds(ids []string) (err error) {
 // ItemsDeleteIDs Wrapper for item.delete
 // Delete the item and return the id of the deleted item
 func (api *API) ItemsDeleteIDs(ids []string) (itemids []interface{}, err error) {
-	response, err := api.CallWithError("item.delete", ids)
+	return api.ItemsDeleteIDsContext(context.Background(), ids)
+}
+
+// ItemsDeleteIDsContext is like ItemsDeleteIDs but uses ctx for the underlying API calls.
+func (api *API) ItemsDeleteIDsContext(ctx context.Context, ids []string) (itemids []interface{}, err error) {
+	response, err := api.CallWithErrorContext(ctx, "item.delete", ids)
 	if err != nil {
 		return
 	}
@@ -442,7 +524,11 @@ func (api *API) ItemsDeleteIDs(ids []string) (itemids []interface{}, err error)
 	return
 }
 func (api *API) ProtoItemsDeleteIDs(ids []string) (itemids []interface{}, err error) {
-	response, err := api.CallWithError("itemprototype.delete", ids)
+	return api.ProtoItemsDeleteIDsContext(context.Background(), ids)
+}
+
+func (api *API) ProtoItemsDeleteIDsContext(ctx context.Context, ids []string) (itemids []interface{}, err error) {
+	response, err := api.CallWithErrorContext(ctx, "itemprototype.delete", ids)
 	if err != nil {
 		return
 	}
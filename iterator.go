@@ -0,0 +1,208 @@
+package zabbix
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// DefaultPageSize number of objects fetched per request by iterators when pageSize is not positive.
+const DefaultPageSize = 1000
+
+// Iterator pages through the results of a get method without loading them in one request.
+//
+// Each request fetches at most pageSize objects sorted by ID, starting after the last
+// object of the previous page: the next ID is sent as "<id>_from", like "eventid_from"
+// of event.get. Objects are returned in ID order; "sortfield" of params is ignored and
+// "limit" caps the total number of objects returned.
+//
+// Use Next and Value to read objects one at a time, or NextPage and Page to read whole pages:
+//
+//	it := api.ItemsIter(zabbix.Params{"hostids": ids}, 500)
+//	for it.Next() {
+//		item := it.Value()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	ctx      context.Context
+	api      *API
+	method   string
+	idField  string
+	params   Params
+	pageSize int
+	fetch    func(ctx context.Context, params Params) ([]T, error)
+	id       func(T) string
+
+	last      string
+	remaining int // objects left under the caller's limit, negative without limit
+	done      bool
+	page      []T
+	pos       int
+	value     T
+	err       error
+}
+
+func newIterator[T any](ctx context.Context, api *API, method, idField string, params Params, pageSize int,
+	fetch func(ctx context.Context, params Params) ([]T, error), id func(T) string) *Iterator[T] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	remaining := -1
+	if limit, err := strconv.Atoi(fmt.Sprint(params["limit"])); err == nil && limit > 0 {
+		remaining = limit
+	}
+	return &Iterator[T]{
+		ctx:       ctx,
+		api:       api,
+		method:    method,
+		idField:   idField,
+		params:    params,
+		pageSize:  pageSize,
+		fetch:     fetch,
+		id:        id,
+		remaining: remaining,
+	}
+}
+
+// copyParams returns a shallow copy of params without keys rejected by paging.
+func copyParams(params Params) Params {
+	res := Params{}
+	for k, v := range params {
+		if k == "preservekeys" {
+			continue
+		}
+		res[k] = v
+	}
+	return res
+}
+
+// pageParams returns the parameters fetching the next page of at most n objects.
+func (it *Iterator[T]) pageParams(n int) (params Params, err error) {
+	params = copyParams(it.params)
+	params["limit"] = n
+	params["sortfield"] = it.idField
+	params["sortorder"] = "ASC"
+
+	// the ID of the last object is needed to request the next page
+	if fields, ok := params["output"].([]string); ok && !containsString(fields, it.idField) {
+		params["output"] = append(append([]string{}, fields...), it.idField)
+	}
+
+	if it.last != "" {
+		last, err := strconv.ParseUint(it.last, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid %s %q: %w", it.method, it.idField, it.last, err)
+		}
+		params[it.idField+"_from"] = strconv.FormatUint(last+1, 10)
+	}
+	return
+}
+
+// NextPage fetches the next page of objects, returning false when there are
+// no more objects or an error occurred.
+func (it *Iterator[T]) NextPage() bool {
+	it.page, it.pos = nil, 0
+	if it.err != nil || it.done {
+		return false
+	}
+
+	n := it.pageSize
+	if it.remaining >= 0 && it.remaining < n {
+		n = it.remaining
+	}
+	params, err := it.pageParams(n)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	page, err := it.fetch(it.ctx, params)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	// a short page is the last one
+	if len(page) < n {
+		it.done = true
+	}
+	if len(page) == 0 {
+		return false
+	}
+	if it.last = it.id(page[len(page)-1]); it.last == "" {
+		it.err = fmt.Errorf("%s: missing %s in result", it.method, it.idField)
+		return false
+	}
+	if it.remaining >= 0 {
+		it.remaining -= len(page)
+		it.done = it.done || it.remaining == 0
+	}
+	it.page = page
+	return true
+}
+
+// Page returns the page fetched by the last NextPage call.
+func (it *Iterator[T]) Page() []T {
+	return it.page
+}
+
+// Next advances to the next object, returning false when there are no more
+// objects or an error occurred.
+func (it *Iterator[T]) Next() bool {
+	for it.pos >= len(it.page) {
+		if !it.NextPage() {
+			return false
+		}
+	}
+	it.value = it.page[it.pos]
+	it.pos++
+	return true
+}
+
+// Value returns the object read by the last Next call.
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Err returns the first error met by the iterator.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// HostsIter Iterates over hosts matching params, fetching pageSize hosts per request.
+func (api *API) HostsIter(params Params, pageSize int) *Iterator[Host] {
+	return api.HostsIterContext(context.Background(), params, pageSize)
+}
+
+// HostsIterContext is like HostsIter but uses ctx for the underlying API calls.
+func (api *API) HostsIterContext(ctx context.Context, params Params, pageSize int) *Iterator[Host] {
+	return newIterator(ctx, api, "host.get", "hostid", params, pageSize, func(ctx context.Context, params Params) ([]Host, error) {
+		return api.HostsGetContext(ctx, params)
+	}, func(v Host) string { return v.HostID })
+}
+
+// ItemsIter Iterates over items matching params, fetching pageSize items per request.
+func (api *API) ItemsIter(params Params, pageSize int) *Iterator[Item] {
+	return api.ItemsIterContext(context.Background(), params, pageSize)
+}
+
+// ItemsIterContext is like ItemsIter but uses ctx for the underlying API calls.
+func (api *API) ItemsIterContext(ctx context.Context, params Params, pageSize int) *Iterator[Item] {
+	return newIterator(ctx, api, "item.get", "itemid", params, pageSize, func(ctx context.Context, params Params) ([]Item, error) {
+		return api.ItemsGetContext(ctx, params)
+	}, func(v Item) string { return v.ItemID })
+}
+
+// TriggersIter Iterates over triggers matching params, fetching pageSize triggers per request.
+func (api *API) TriggersIter(params Params, pageSize int) *Iterator[Trigger] {
+	return api.TriggersIterContext(context.Background(), params, pageSize)
+}
+
+// TriggersIterContext is like TriggersIter but uses ctx for the underlying API calls.
+func (api *API) TriggersIterContext(ctx context.Context, params Params, pageSize int) *Iterator[Trigger] {
+	return newIterator(ctx, api, "trigger.get", "triggerid", params, pageSize, func(ctx context.Context, params Params) ([]Trigger, error) {
+		return api.TriggersGetContext(ctx, params)
+	}, func(v Trigger) string { return v.TriggerID })
+}
@@ -0,0 +1,157 @@
+package zabbix_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestItemsIter(t *testing.T) {
+	api := getAPI(t)
+
+	it := api.ItemsIter(zapi.Params{"limit": 50}, 20)
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n > 50 {
+		t.Errorf("limit not applied: got %d items", n)
+	}
+}
+
+func TestIteratorFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	var hostIDs []string
+	for i := 0; i < 25; i++ {
+		ids := srv.Add("host", map[string]interface{}{"host": fmt.Sprintf("host%02d", i), "status": i % 2})
+		hostIDs = append(hostIDs, ids[0])
+		srv.Add("item", map[string]interface{}{"hostid": ids[0], "key_": "agent.ping", "name": "Ping", "value_type": 3})
+	}
+
+	var sizes []int
+	it := api.HostsIter(zapi.Params{"output": []string{"host"}}, 10)
+	for it.NextPage() {
+		sizes = append(sizes, len(it.Page()))
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(sizes) != "[10 10 5]" {
+		t.Errorf("unexpected page sizes %v", sizes)
+	}
+
+	hosts := api.HostsIter(zapi.Params{"filter": map[string]interface{}{"status": zapi.Monitored}}, 4)
+	var prev string
+	n := 0
+	for hosts.Next() {
+		h := hosts.Value()
+		if h.Status != zapi.Monitored || h.Host <= prev {
+			t.Errorf("unexpected host %#v after %q", h, prev)
+		}
+		prev = h.Host
+		n++
+	}
+	if err := hosts.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 13 {
+		t.Errorf("expected 13 monitored hosts, got %d", n)
+	}
+
+	items := api.ItemsIter(zapi.Params{"hostids": hostIDs[:3]}, 0)
+	n = 0
+	for items.Next() {
+		n++
+	}
+	if err := items.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("expected 3 items, got %d", n)
+	}
+
+	api.SetAuthenticator(nil) // report the expiry instead of logging in again
+	srv.ExpireSessions()
+	triggers := api.TriggersIter(zapi.Params{}, 10)
+	if triggers.Next() || triggers.Err() == nil {
+		t.Error("expected iterator error after session expiry")
+	}
+}
+
+// pageRecorder records the number of objects returned by each request.
+type pageRecorder struct {
+	sizes []int
+}
+
+func (r *pageRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(b))
+
+	var body struct {
+		Result json.RawMessage `json:"result"`
+	}
+	var rows []json.RawMessage
+	if json.Unmarshal(b, &body) == nil && json.Unmarshal(body.Result, &rows) == nil {
+		r.sizes = append(r.sizes, len(rows))
+	}
+	return res, nil
+}
+
+func TestIteratorPageSizeFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+	for i := 0; i < 25; i++ {
+		srv.Add("host", map[string]interface{}{"host": fmt.Sprintf("host%02d", i)})
+	}
+	rec := &pageRecorder{}
+	api.SetClient(&http.Client{Transport: rec})
+
+	var hosts []string
+	it := api.HostsIter(zapi.Params{"output": []string{"host"}}, 10)
+	for it.Next() {
+		hosts = append(hosts, it.Value().Host)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 25 || hosts[0] != "host00" || hosts[24] != "host24" {
+		t.Errorf("unexpected hosts %v", hosts)
+	}
+	for _, n := range rec.sizes {
+		if n > 10 {
+			t.Errorf("request returned %d rows, more than the page size", n)
+		}
+	}
+	if fmt.Sprint(rec.sizes) != "[10 10 5]" {
+		t.Errorf("unexpected requests %v", rec.sizes)
+	}
+
+	rec.sizes = nil
+	it = api.HostsIter(zapi.Params{"limit": 15}, 10)
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 15 || fmt.Sprint(rec.sizes) != "[10 5]" {
+		t.Errorf("limit not applied: got %d hosts in requests %v", n, rec.sizes)
+	}
+}
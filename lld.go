@@ -1,6 +1,7 @@
 package zabbix
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 )
@@ -166,10 +167,15 @@ func prepLLDs(item LLDRules) {
 // ItemsGet Wrapper for item.get
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/get
 func (api *API) LLDsGet(params Params) (res LLDRules, err error) {
+	return api.LLDsGetContext(context.Background(), params)
+}
+
+// LLDsGetContext is like LLDsGet but uses ctx for the underlying API calls.
+func (api *API) LLDsGetContext(ctx context.Context, params Params) (res LLDRules, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("discoveryrule.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "discoveryrule.get", params, &res)
 	if err != nil {
 		return
 	}
@@ -179,7 +185,12 @@ func (api *API) LLDsGet(params Params) (res LLDRules, err error) {
 
 // ItemGetByID Gets item by Id only if there is exactly 1 matching host.
 func (api *API) LLDGetByID(id string) (res *LLDRule, err error) {
-	items, err := api.LLDsGet(Params{"itemids": id})
+	return api.LLDGetByIDContext(context.Background(), id)
+}
+
+// LLDGetByIDContext is like LLDGetByID but uses ctx for the underlying API calls.
+func (api *API) LLDGetByIDContext(ctx context.Context, id string) (res *LLDRule, err error) {
+	items, err := api.LLDsGetContext(ctx, Params{"itemids": id})
 	if err != nil {
 		return
 	}
@@ -196,8 +207,13 @@ func (api *API) LLDGetByID(id string) (res *LLDRule, err error) {
 // ItemsCreate Wrapper for item.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/create
 func (api *API) LLDsCreate(items LLDRules) (err error) {
+	return api.LLDsCreateContext(context.Background(), items)
+}
+
+// LLDsCreateContext is like LLDsCreate but uses ctx for the underlying API calls.
+func (api *API) LLDsCreateContext(ctx context.Context, items LLDRules) (err error) {
 	prepLLDs(items)
-	response, err := api.CallWithError("discoveryrule.create", items)
+	response, err := api.CallWithErrorContext(ctx, "discoveryrule.create", items)
 	if err != nil {
 		return
 	}
@@ -213,8 +229,13 @@ func (api *API) LLDsCreate(items LLDRules) (err error) {
 // ItemsUpdate Wrapper for item.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/update
 func (api *API) LLDsUpdate(items LLDRules) (err error) {
+	return api.LLDsUpdateContext(context.Background(), items)
+}
+
+// LLDsUpdateContext is like LLDsUpdate but uses ctx for the underlying API calls.
+func (api *API) LLDsUpdateContext(ctx context.Context, items LLDRules) (err error) {
 	prepLLDs(items)
-	_, err = api.CallWithError("discoveryrule.update", items)
+	_, err = api.CallWithErrorContext(ctx, "discoveryrule.update", items)
 	return
 }
 
@@ -222,12 +243,17 @@ func (api *API) LLDsUpdate(items LLDRules) (err error) {
 // Cleans ItemId in all items elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/delete
 func (api *API) LLDsDelete(items LLDRules) (err error) {
+	return api.LLDsDeleteContext(context.Background(), items)
+}
+
+// LLDsDeleteContext is like LLDsDelete but uses ctx for the underlying API calls.
+func (api *API) LLDsDeleteContext(ctx context.Context, items LLDRules) (err error) {
 	ids := make([]string, len(items))
 	for i, item := range items {
 		ids[i] = item.ItemID
 	}
 
-	err = api.LLDDeleteByIds(ids)
+	err = api.LLDDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range items {
 			items[i].ItemID = ""
@@ -239,7 +265,12 @@ func (api *API) LLDsDelete(items LLDRules) (err error) {
 // ItemsDeleteByIds Wrapper for item.delete
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/item/delete
 func (api *API) LLDDeleteByIds(ids []string) (err error) {
-	deleteIds, err := api.LLDDeleteIDs(ids)
+	return api.LLDDeleteByIdsContext(context.Background(), ids)
+}
+
+// LLDDeleteByIdsContext is like LLDDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) LLDDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	deleteIds, err := api.LLDDeleteIDsContext(ctx, ids)
 	if err != nil {
 		return
 	}
@@ -253,7 +284,12 @@ func (api *API) LLDDeleteByIds(ids []string) (err error) {
 // ItemsDeleteIDs Wrapper for item.delete
 // Delete the item and return the id of the deleted item
 func (api *API) LLDDeleteIDs(ids []string) (itemids []interface{}, err error) {
-	response, err := api.CallWithError("discoveryrule.delete", ids)
+	return api.LLDDeleteIDsContext(context.Background(), ids)
+}
+
+// LLDDeleteIDsContext is like LLDDeleteIDs but uses ctx for the underlying API calls.
+func (api *API) LLDDeleteIDsContext(ctx context.Context, ids []string) (itemids []interface{}, err error) {
+	response, err := api.CallWithErrorContext(ctx, "discoveryrule.delete", ids)
 	if err != nil {
 		return
 	}
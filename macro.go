@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 // Macro represent Zabbix User Macro object
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/usermacro/object
 type Macro struct {
@@ -16,16 +18,26 @@ type Macros []Macro
 // MacrosGet Wrapper for usermacro.get
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/get
 func (api *API) MacrosGet(params Params) (res Macros, err error) {
+	return api.MacrosGetContext(context.Background(), params)
+}
+
+// MacrosGetContext is like MacrosGet but uses ctx for the underlying API calls.
+func (api *API) MacrosGetContext(ctx context.Context, params Params) (res Macros, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("usermacro.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "usermacro.get", params, &res)
 	return
 }
 
 // MacroGetByID Get macro by macro ID if there is exactly 1 matching macro
 func (api *API) MacroGetByID(id string) (res *Macro, err error) {
-	triggers, err := api.MacrosGet(Params{"hostmacroids": id})
+	return api.MacroGetByIDContext(context.Background(), id)
+}
+
+// MacroGetByIDContext is like MacroGetByID but uses ctx for the underlying API calls.
+func (api *API) MacroGetByIDContext(ctx context.Context, id string) (res *Macro, err error) {
+	triggers, err := api.MacrosGetContext(ctx, Params{"hostmacroids": id})
 	if err != nil {
 		return
 	}
@@ -42,7 +54,12 @@ func (api *API) MacroGetByID(id string) (res *Macro, err error) {
 // MacrosCreate Wrapper for usermacro.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/create
 func (api *API) MacrosCreate(macros Macros) error {
-	response, err := api.CallWithError("usermacro.create", macros)
+	return api.MacrosCreateContext(context.Background(), macros)
+}
+
+// MacrosCreateContext is like MacrosCreate but uses ctx for the underlying API calls.
+func (api *API) MacrosCreateContext(ctx context.Context, macros Macros) error {
+	response, err := api.CallWithErrorContext(ctx, "usermacro.create", macros)
 	if err != nil {
 		return err
 	}
@@ -58,7 +75,12 @@ func (api *API) MacrosCreate(macros Macros) error {
 // MacrosUpdate Wrapper for usermacro.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/update
 func (api *API) MacrosUpdate(macros Macros) (err error) {
-	_, err = api.CallWithError("usermacro.update", macros)
+	return api.MacrosUpdateContext(context.Background(), macros)
+}
+
+// MacrosUpdateContext is like MacrosUpdate but uses ctx for the underlying API calls.
+func (api *API) MacrosUpdateContext(ctx context.Context, macros Macros) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "usermacro.update", macros)
 	return
 }
 
@@ -66,7 +88,12 @@ func (api *API) MacrosUpdate(macros Macros) (err error) {
 // Cleans MacroId in all macro elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/delete
 func (api *API) MacrosDeleteByIDs(ids []string) (err error) {
-	response, err := api.CallWithError("usermacro.delete", ids)
+	return api.MacrosDeleteByIDsContext(context.Background(), ids)
+}
+
+// MacrosDeleteByIDsContext is like MacrosDeleteByIDs but uses ctx for the underlying API calls.
+func (api *API) MacrosDeleteByIDsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "usermacro.delete", ids)
 	if err != nil {
 		return
 	}
@@ -82,12 +109,17 @@ func (api *API) MacrosDeleteByIDs(ids []string) (err error) {
 // MacrosDelete Wrapper for usermacro.delete
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/usermacro/delete
 func (api *API) MacrosDelete(macros Macros) (err error) {
+	return api.MacrosDeleteContext(context.Background(), macros)
+}
+
+// MacrosDeleteContext is like MacrosDelete but uses ctx for the underlying API calls.
+func (api *API) MacrosDeleteContext(ctx context.Context, macros Macros) (err error) {
 	ids := make([]string, len(macros))
 	for i, macro := range macros {
 		ids[i] = macro.MacroID
 	}
 
-	err = api.MacrosDeleteByIDs(ids)
+	err = api.MacrosDeleteByIDsContext(ctx, ids)
 	if err == nil {
 		for i := range macros {
 			macros[i].MacroID = ""
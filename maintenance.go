@@ -0,0 +1,283 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+type (
+	// MaintenanceType data collection during maintenance
+	// see "maintenance_type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object
+	MaintenanceType int
+
+	// MaintenanceTagsEvalType evaluation method of problem tags
+	// see "tags_evaltype" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object
+	MaintenanceTagsEvalType int
+
+	// MaintenanceTagOperatorType operator of a problem tag
+	// see "operator" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object#problem-tag
+	MaintenanceTagOperatorType int
+
+	// TimePeriodType type of a maintenance time period
+	// see "timeperiod_type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object#time-period
+	TimePeriodType int
+
+	// DayOfWeekMask bitmask of week days, Monday is 1 and Sunday is 64
+	// see "dayofweek" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object#time-period
+	DayOfWeekMask int
+
+	// MonthMask bitmask of months, January is 1 and December is 2048
+	// see "month" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object#time-period
+	MonthMask int
+)
+
+const (
+	// MaintenanceWithData data is collected
+	MaintenanceWithData MaintenanceType = 0
+	// MaintenanceNoData data is not collected
+	MaintenanceNoData MaintenanceType = 1
+)
+
+const (
+	// MaintenanceTagsAndOr and/or
+	MaintenanceTagsAndOr MaintenanceTagsEvalType = 0
+	// MaintenanceTagsOr or
+	MaintenanceTagsOr MaintenanceTagsEvalType = 2
+)
+
+const (
+	// MaintenanceTagEquals tag value equals
+	MaintenanceTagEquals MaintenanceTagOperatorType = 0
+	// MaintenanceTagContains tag value contains
+	MaintenanceTagContains MaintenanceTagOperatorType = 2
+)
+
+const (
+	// TimePeriodOneTime one time only
+	TimePeriodOneTime TimePeriodType = 0
+	// TimePeriodDaily daily
+	TimePeriodDaily TimePeriodType = 2
+	// TimePeriodWeekly weekly
+	TimePeriodWeekly TimePeriodType = 3
+	// TimePeriodMonthly monthly
+	TimePeriodMonthly TimePeriodType = 4
+)
+
+// WeekDays returns the mask of the given days.
+func WeekDays(days ...time.Weekday) (res DayOfWeekMask) {
+	for _, d := range days {
+		// time.Sunday is 0, Zabbix starts the week on Monday
+		res |= 1 << uint((d+6)%7)
+	}
+	return
+}
+
+// Months returns the mask of the given months.
+func Months(months ...time.Month) (res MonthMask) {
+	for _, m := range months {
+		res |= 1 << uint(m-1)
+	}
+	return
+}
+
+// MaintenanceTag problem tag suppressed by a maintenance
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object#problem-tag
+type MaintenanceTag struct {
+	Tag
+	Operator MaintenanceTagOperatorType `json:"operator,string"`
+}
+
+// MaintenanceTags is an array of MaintenanceTag
+type MaintenanceTags []MaintenanceTag
+
+// MaintenanceTimePeriod time period of a maintenance
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object#time-period
+type MaintenanceTimePeriod struct {
+	TimePeriodType TimePeriodType `json:"timeperiod_type,string"`
+	// Period duration in seconds
+	Period int `json:"period,string"`
+	// StartDate Unix timestamp of one time periods
+	StartDate int64 `json:"start_date,omitempty,string"`
+	// StartTime seconds since midnight of repeating periods
+	StartTime int `json:"start_time,omitempty,string"`
+	// Every days or weeks between daily and weekly periods, week of the month for monthly periods
+	Every     int           `json:"every,omitempty,string"`
+	DayOfWeek DayOfWeekMask `json:"dayofweek,omitempty,string"`
+	Day       int           `json:"day,omitempty,string"`
+	Month     MonthMask     `json:"month,omitempty,string"`
+}
+
+// MaintenanceTimePeriods is an array of MaintenanceTimePeriod
+type MaintenanceTimePeriods []MaintenanceTimePeriod
+
+// OneTimePeriod builds a one time period starting at start and lasting d.
+func OneTimePeriod(start time.Time, d time.Duration) MaintenanceTimePeriod {
+	return MaintenanceTimePeriod{
+		TimePeriodType: TimePeriodOneTime,
+		StartDate:      start.Unix(),
+		Period:         int(d / time.Second),
+	}
+}
+
+// Maintenance represent Zabbix maintenance object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/object
+type Maintenance struct {
+	MaintenanceID   string                  `json:"maintenanceid,omitempty"`
+	Name            string                  `json:"name"`
+	ActiveSince     int64                   `json:"active_since,string"`
+	ActiveTill      int64                   `json:"active_till,string"`
+	Description     string                  `json:"description,omitempty"`
+	MaintenanceType MaintenanceType         `json:"maintenance_type,string"`
+	TagsEvalType    MaintenanceTagsEvalType `json:"tags_evaltype,string"`
+
+	Groups      HostGroupIDs           `json:"groups,omitempty"`
+	Hosts       HostIDs                `json:"hosts,omitempty"`
+	TimePeriods MaintenanceTimePeriods `json:"timeperiods"`
+	Tags        MaintenanceTags        `json:"tags,omitempty"`
+}
+
+// UnmarshalJSON reads host groups returned under "hostgroups" by maintenance.get into Groups.
+func (m *Maintenance) UnmarshalJSON(data []byte) error {
+	type plain Maintenance
+	aux := struct {
+		*plain
+		HostGroups HostGroupIDs `json:"hostgroups"`
+	}{plain: (*plain)(m)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.HostGroups != nil {
+		m.Groups = aux.HostGroups
+	}
+	return nil
+}
+
+// Maintenances is an array of Maintenance
+type Maintenances []Maintenance
+
+// OneTimeMaintenance builds a maintenance active from start for d with a single one time period.
+func OneTimeMaintenance(name string, start time.Time, d time.Duration) Maintenance {
+	return Maintenance{
+		Name:        name,
+		ActiveSince: start.Unix(),
+		ActiveTill:  start.Add(d).Unix(),
+		TimePeriods: MaintenanceTimePeriods{OneTimePeriod(start, d)},
+	}
+}
+
+// MaintenancesGet Wrapper for maintenance.get
+// Selects host groups, hosts, time periods and tags unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/get
+func (api *API) MaintenancesGet(params Params) (res Maintenances, err error) {
+	return api.MaintenancesGetContext(context.Background(), params)
+}
+
+// MaintenancesGetContext is like MaintenancesGet but uses ctx for the underlying API calls.
+func (api *API) MaintenancesGetContext(ctx context.Context, params Params) (res Maintenances, err error) {
+	for _, key := range []string{"output", "selectHostGroups", "selectHosts", "selectTimeperiods", "selectTags"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "maintenance.get", params, &res)
+	return
+}
+
+// MaintenanceGetByID Gets maintenance by ID only if there is exactly 1 matching maintenance.
+func (api *API) MaintenanceGetByID(id string) (res *Maintenance, err error) {
+	return api.MaintenanceGetByIDContext(context.Background(), id)
+}
+
+// MaintenanceGetByIDContext is like MaintenanceGetByID but uses ctx for the underlying API calls.
+func (api *API) MaintenanceGetByIDContext(ctx context.Context, id string) (res *Maintenance, err error) {
+	maintenances, err := api.MaintenancesGetContext(ctx, Params{"maintenanceids": id})
+	if err != nil {
+		return
+	}
+
+	if len(maintenances) == 1 {
+		res = &maintenances[0]
+	} else {
+		e := ExpectedOneResult(len(maintenances))
+		err = &e
+	}
+	return
+}
+
+// MaintenancesCreate Wrapper for maintenance.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/create
+func (api *API) MaintenancesCreate(maintenances Maintenances) (err error) {
+	return api.MaintenancesCreateContext(context.Background(), maintenances)
+}
+
+// MaintenancesCreateContext is like MaintenancesCreate but uses ctx for the underlying API calls.
+func (api *API) MaintenancesCreateContext(ctx context.Context, maintenances Maintenances) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "maintenance.create", maintenances)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	maintenanceids := result["maintenanceids"].([]interface{})
+	for i, id := range maintenanceids {
+		maintenances[i].MaintenanceID = id.(string)
+	}
+	return
+}
+
+// MaintenancesUpdate Wrapper for maintenance.update
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/update
+func (api *API) MaintenancesUpdate(maintenances Maintenances) (err error) {
+	return api.MaintenancesUpdateContext(context.Background(), maintenances)
+}
+
+// MaintenancesUpdateContext is like MaintenancesUpdate but uses ctx for the underlying API calls.
+func (api *API) MaintenancesUpdateContext(ctx context.Context, maintenances Maintenances) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "maintenance.update", maintenances)
+	return
+}
+
+// MaintenancesDelete Wrapper for maintenance.delete
+// Cleans MaintenanceID in all maintenances elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/delete
+func (api *API) MaintenancesDelete(maintenances Maintenances) (err error) {
+	return api.MaintenancesDeleteContext(context.Background(), maintenances)
+}
+
+// MaintenancesDeleteContext is like MaintenancesDelete but uses ctx for the underlying API calls.
+func (api *API) MaintenancesDeleteContext(ctx context.Context, maintenances Maintenances) (err error) {
+	ids := make([]string, len(maintenances))
+	for i, maintenance := range maintenances {
+		ids[i] = maintenance.MaintenanceID
+	}
+
+	err = api.MaintenancesDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range maintenances {
+			maintenances[i].MaintenanceID = ""
+		}
+	}
+	return
+}
+
+// MaintenancesDeleteByIds Wrapper for maintenance.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/maintenance/delete
+func (api *API) MaintenancesDeleteByIds(ids []string) (err error) {
+	return api.MaintenancesDeleteByIdsContext(context.Background(), ids)
+}
+
+// MaintenancesDeleteByIdsContext is like MaintenancesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) MaintenancesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "maintenance.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	maintenanceids := result["maintenanceids"].([]interface{})
+	if len(ids) != len(maintenanceids) {
+		err = &ExpectedMore{len(ids), len(maintenanceids)}
+	}
+	return
+}
@@ -0,0 +1,79 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestMaintenancesGet(t *testing.T) {
+	api := getAPI(t)
+
+	if _, err := api.MaintenancesGet(zapi.Params{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMaintenancesFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	groupIDs := srv.Add("hostgroup", map[string]interface{}{"name": "Linux servers"})
+	start := time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC)
+
+	m := zapi.OneTimeMaintenance("Patching", start, 2*time.Hour)
+	m.MaintenanceType = zapi.MaintenanceNoData
+	m.Groups = zapi.HostGroupIDs{{GroupID: groupIDs[0]}}
+	m.TimePeriods = append(m.TimePeriods, zapi.MaintenanceTimePeriod{
+		TimePeriodType: zapi.TimePeriodWeekly,
+		Period:         3600,
+		StartTime:      2 * 3600,
+		Every:          1,
+		DayOfWeek:      zapi.WeekDays(time.Saturday, time.Sunday),
+	})
+	m.Tags = zapi.MaintenanceTags{{Tag: zapi.Tag{Tag: "service", Value: "db"}, Operator: zapi.MaintenanceTagContains}}
+
+	maintenances := zapi.Maintenances{m}
+	if err := api.MaintenancesCreate(maintenances); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := api.MaintenanceGetByID(maintenances[0].MaintenanceID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ActiveTill-got.ActiveSince != 7200 || got.MaintenanceType != zapi.MaintenanceNoData {
+		t.Errorf("unexpected maintenance %#v", got)
+	}
+	if len(got.Groups) != 1 || got.Groups[0].GroupID != groupIDs[0] {
+		t.Errorf("unexpected groups %#v", got.Groups)
+	}
+	if len(got.TimePeriods) != 2 || got.TimePeriods[0].StartDate != start.Unix() || got.TimePeriods[1].DayOfWeek != 96 {
+		t.Errorf("unexpected time periods %#v", got.TimePeriods)
+	}
+	if len(got.Tags) != 1 || got.Tags[0].Value != "db" || got.Tags[0].Operator != zapi.MaintenanceTagContains {
+		t.Errorf("unexpected tags %#v", got.Tags)
+	}
+
+	if err = api.MaintenancesDelete(maintenances); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMaintenanceMasks(t *testing.T) {
+	if zapi.WeekDays(time.Monday) != 1 || zapi.WeekDays(time.Sunday) != 64 {
+		t.Error("unexpected day of week mask")
+	}
+	if zapi.Months(time.January, time.December) != 2049 {
+		t.Error("unexpected month mask")
+	}
+
+	var m zapi.Maintenance
+	if err := json.Unmarshal([]byte(`{"maintenanceid":"1","hostgroups":[{"groupid":"4"}]}`), &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.MaintenanceID != "1" || len(m.Groups) != 1 || m.Groups[0].GroupID != "4" {
+		t.Errorf("hostgroups not decoded into Groups: %#v", m)
+	}
+}
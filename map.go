@@ -0,0 +1,480 @@
+package zabbix
+
+import (
+	"context"
+	"math"
+	"strconv"
+)
+
+type (
+	// MapSharingType whether a map is visible to all users
+	// see "private" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object
+	MapSharingType int
+
+	// MapPermission access granted to a user or user group a map is shared with
+	// see "permission" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-user
+	MapPermission int
+
+	// MapElementType type of object a map element represents
+	// see "elementtype" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-element
+	MapElementType int
+
+	// MapElementSubtype how a host group element is shown
+	// see "elementsubtype" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-element
+	MapElementSubtype int
+
+	// MapAreaType size of the area of a host group element shown as separate hosts
+	// see "areatype" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-element
+	MapAreaType int
+
+	// MapDrawType style of a link
+	// see "drawtype" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-link
+	MapDrawType int
+
+	// MapShapeType type of a map shape
+	// see "type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-shapes
+	MapShapeType int
+
+	// MapLineType style of a map line
+	// see "line_type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-lines
+	MapLineType int
+)
+
+const (
+	// MapPublic map is visible to all users
+	MapPublic MapSharingType = 0
+	// MapPrivate map is visible to its owner and the users and groups it is shared with
+	MapPrivate MapSharingType = 1
+)
+
+const (
+	// MapReadOnly read-only access
+	MapReadOnly MapPermission = 2
+	// MapReadWrite read-write access
+	MapReadWrite MapPermission = 3
+)
+
+const (
+	// MapElementHost host
+	MapElementHost MapElementType = 0
+	// MapElementMap map
+	MapElementMap MapElementType = 1
+	// MapElementTrigger trigger
+	MapElementTrigger MapElementType = 2
+	// MapElementHostGroup host group
+	MapElementHostGroup MapElementType = 3
+	// MapElementImage image
+	MapElementImage MapElementType = 4
+)
+
+const (
+	// MapElementSingleHostGroup host group is shown as a single element
+	MapElementSingleHostGroup MapElementSubtype = 0
+	// MapElementHostGroupElements each host of the group is shown as an element
+	MapElementHostGroupElements MapElementSubtype = 1
+)
+
+const (
+	// MapAreaWholeMap hosts are spread over the whole map
+	MapAreaWholeMap MapAreaType = 0
+	// MapAreaCustomSize hosts are spread over the Width and Height of the element
+	MapAreaCustomSize MapAreaType = 1
+)
+
+const (
+	// MapDrawLine line
+	MapDrawLine MapDrawType = 0
+	// MapDrawBold bold line
+	MapDrawBold MapDrawType = 2
+	// MapDrawDotted dotted line
+	MapDrawDotted MapDrawType = 3
+	// MapDrawDashed dashed line
+	MapDrawDashed MapDrawType = 4
+)
+
+const (
+	// MapShapeRectangle rectangle
+	MapShapeRectangle MapShapeType = 0
+	// MapShapeEllipse ellipse
+	MapShapeEllipse MapShapeType = 1
+)
+
+const (
+	// MapLineNone no line
+	MapLineNone MapLineType = 0
+	// MapLineSolid solid line
+	MapLineSolid MapLineType = 1
+	// MapLineDotted dotted line
+	MapLineDotted MapLineType = 2
+	// MapLineDashed dashed line
+	MapLineDashed MapLineType = 3
+)
+
+// MapElementObject references the object of a map element
+// Only the ID matching the MapElementType of the element is set.
+type MapElementObject struct {
+	HostID    string `json:"hostid,omitempty"`
+	GroupID   string `json:"groupid,omitempty"`
+	TriggerID string `json:"triggerid,omitempty"`
+	MapID     string `json:"sysmapid,omitempty"`
+}
+
+// MapElementObjects is an array of MapElementObject
+type MapElementObjects []MapElementObject
+
+// MapElementURL represent a URL of a map element
+type MapElementURL struct {
+	ElementURLID string `json:"sysmapelementurlid,omitempty"`
+	Name         string `json:"name"`
+	URL          string `json:"url"`
+}
+
+// MapElementURLs is an array of MapElementURL
+type MapElementURLs []MapElementURL
+
+// MapElement represent an element of a map
+// On create, ElementID may be set to any value unique in the map to reference the element in links.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-element
+type MapElement struct {
+	ElementID   string         `json:"selementid,omitempty"`
+	ElementType MapElementType `json:"elementtype,string"`
+	// Elements is empty for images, and may hold several triggers for trigger elements
+	Elements MapElementObjects `json:"elements,omitempty"`
+	// IconIDOff image shown in the default state, required
+	IconIDOff         string `json:"iconid_off"`
+	IconIDOn          string `json:"iconid_on,omitempty"`
+	IconIDDisabled    string `json:"iconid_disabled,omitempty"`
+	IconIDMaintenance string `json:"iconid_maintenance,omitempty"`
+	Label             string `json:"label,omitempty"`
+	X                 int    `json:"x,string"`
+	Y                 int    `json:"y,string"`
+
+	// Host group elements
+	ElementSubtype MapElementSubtype `json:"elementsubtype,string"`
+	AreaType       MapAreaType       `json:"areatype,string"`
+	Width          int               `json:"width,string,omitempty"`
+	Height         int               `json:"height,string,omitempty"`
+
+	URLs MapElementURLs `json:"urls,omitempty"`
+}
+
+// MapElements is an array of MapElement
+type MapElements []MapElement
+
+// MapLinkTrigger colors a link while the trigger is in problem state
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-link-trigger
+type MapLinkTrigger struct {
+	LinkTriggerID string `json:"linktriggerid,omitempty"`
+	TriggerID     string `json:"triggerid"`
+	// Color in hexadecimal RRGGBB notation
+	Color    string      `json:"color,omitempty"`
+	DrawType MapDrawType `json:"drawtype,string"`
+}
+
+// MapLinkTriggers is an array of MapLinkTrigger
+type MapLinkTriggers []MapLinkTrigger
+
+// MapLink represent a link between two map elements
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-link
+type MapLink struct {
+	LinkID     string `json:"linkid,omitempty"`
+	ElementID1 string `json:"selementid1"`
+	ElementID2 string `json:"selementid2"`
+	// Color in hexadecimal RRGGBB notation, empty uses the server default
+	Color    string          `json:"color,omitempty"`
+	DrawType MapDrawType     `json:"drawtype,string"`
+	Label    string          `json:"label,omitempty"`
+	Triggers MapLinkTriggers `json:"linktriggers,omitempty"`
+}
+
+// MapLinks is an array of MapLink
+type MapLinks []MapLink
+
+// MapShape represent a rectangle or ellipse, optionally with text, drawn on a map
+// Colors are in hexadecimal RRGGBB notation, empty ones use the server default.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-shapes
+type MapShape struct {
+	ShapeID         string       `json:"sysmap_shapeid,omitempty"`
+	Type            MapShapeType `json:"type,string"`
+	X               int          `json:"x,string"`
+	Y               int          `json:"y,string"`
+	Width           int          `json:"width,string"`
+	Height          int          `json:"height,string"`
+	Text            string       `json:"text,omitempty"`
+	FontColor       string       `json:"font_color,omitempty"`
+	BorderColor     string       `json:"border_color,omitempty"`
+	BackgroundColor string       `json:"background_color,omitempty"`
+	ZIndex          int          `json:"zindex,string,omitempty"`
+}
+
+// MapShapes is an array of MapShape
+type MapShapes []MapShape
+
+// MapLine represent a line drawn on a map
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-lines
+type MapLine struct {
+	LineID string `json:"sysmap_shapeid,omitempty"`
+	X1     int    `json:"x1,string"`
+	Y1     int    `json:"y1,string"`
+	X2     int    `json:"x2,string"`
+	Y2     int    `json:"y2,string"`
+	// LineType zero value draws nothing, use MapLineSolid for a visible line
+	LineType  MapLineType `json:"line_type,string"`
+	LineWidth int         `json:"line_width,string,omitempty"`
+	// LineColor in hexadecimal RRGGBB notation, empty uses the server default
+	LineColor string `json:"line_color,omitempty"`
+	ZIndex    int    `json:"zindex,string,omitempty"`
+}
+
+// MapLines is an array of MapLine
+type MapLines []MapLine
+
+// MapURL represent a URL added to all map elements of a type
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object#map-url
+type MapURL struct {
+	MapURLID    string         `json:"sysmapurlid,omitempty"`
+	Name        string         `json:"name"`
+	URL         string         `json:"url"`
+	ElementType MapElementType `json:"elementtype,string"`
+}
+
+// MapURLs is an array of MapURL
+type MapURLs []MapURL
+
+// MapUser represent a user a map is shared with
+type MapUser struct {
+	MapUserID  string        `json:"sysmapuserid,omitempty"`
+	UserID     string        `json:"userid"`
+	Permission MapPermission `json:"permission,string"`
+}
+
+// MapUsers is an array of MapUser
+type MapUsers []MapUser
+
+// MapUserGroup represent a user group a map is shared with
+type MapUserGroup struct {
+	MapUserGroupID string        `json:"sysmapusrgrpid,omitempty"`
+	UserGroupID    string        `json:"usrgrpid"`
+	Permission     MapPermission `json:"permission,string"`
+}
+
+// MapUserGroups is an array of MapUserGroup
+type MapUserGroups []MapUserGroup
+
+// Map represent Zabbix network map object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/object
+type Map struct {
+	MapID  string `json:"sysmapid,omitempty"`
+	Name   string `json:"name"`
+	Width  int    `json:"width,string"`
+	Height int    `json:"height,string"`
+	// UserID of the owner, empty makes the current user the owner
+	UserID       string         `json:"userid,omitempty"`
+	Private      MapSharingType `json:"private,string"`
+	BackgroundID string         `json:"backgroundid,omitempty"`
+	// SeverityMin problems of lower severity are not shown
+	SeverityMin SeverityType `json:"severity_min,string"`
+
+	// Fields below are only returned when requested with the matching select* parameter
+	Elements   MapElements   `json:"selements,omitempty"`
+	Links      MapLinks      `json:"links,omitempty"`
+	Shapes     MapShapes     `json:"shapes,omitempty"`
+	Lines      MapLines      `json:"lines,omitempty"`
+	URLs       MapURLs       `json:"urls,omitempty"`
+	Users      MapUsers      `json:"users,omitempty"`
+	UserGroups MapUserGroups `json:"userGroups,omitempty"`
+}
+
+// Maps is an array of Map
+type Maps []Map
+
+// MapGrid describes the grid Map.AddHostGrid lays hosts out in
+type MapGrid struct {
+	// IconID image shown for the hosts, required
+	IconID string
+	// Columns per row, zero lays the hosts out in a square
+	Columns int
+	// CellWidth and CellHeight size of a cell in pixels, zero uses 100
+	CellWidth  int
+	CellHeight int
+	// X and Y position of the top left cell
+	X int
+	Y int
+}
+
+// AddHostGrid adds an element for each host, row by row in the order of hosts,
+// labeled with the host name. The map grows to fit the grid if needed.
+// Returns the ElementIDs of the added elements, usable in links.
+func (m *Map) AddHostGrid(hosts Hosts, grid MapGrid) (ids []string) {
+	if len(hosts) == 0 {
+		return
+	}
+	columns := grid.Columns
+	if columns <= 0 {
+		columns = int(math.Ceil(math.Sqrt(float64(len(hosts)))))
+	}
+	width, height := grid.CellWidth, grid.CellHeight
+	if width <= 0 {
+		width = 100
+	}
+	if height <= 0 {
+		height = 100
+	}
+
+	next := m.nextElementID()
+	for i, host := range hosts {
+		label := host.Name
+		if label == "" {
+			label = host.Host
+		}
+		id := strconv.Itoa(next + i)
+		m.Elements = append(m.Elements, MapElement{
+			ElementID:   id,
+			ElementType: MapElementHost,
+			Elements:    MapElementObjects{{HostID: host.HostID}},
+			IconIDOff:   grid.IconID,
+			Label:       label,
+			X:           grid.X + i%columns*width,
+			Y:           grid.Y + i/columns*height,
+		})
+		ids = append(ids, id)
+	}
+
+	rows := (len(hosts) + columns - 1) / columns
+	if w := grid.X + columns*width; m.Width < w {
+		m.Width = w
+	}
+	if h := grid.Y + rows*height; m.Height < h {
+		m.Height = h
+	}
+	return
+}
+
+// nextElementID returns a numeric element ID higher than the ones of m.
+func (m *Map) nextElementID() int {
+	next := 1
+	for _, e := range m.Elements {
+		if n, err := strconv.Atoi(e.ElementID); err == nil && n >= next {
+			next = n + 1
+		}
+	}
+	return next
+}
+
+// MapsGet Wrapper for map.get
+// Selects elements, links, shapes, lines, URLs and sharing unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/get
+func (api *API) MapsGet(params Params) (res Maps, err error) {
+	return api.MapsGetContext(context.Background(), params)
+}
+
+// MapsGetContext is like MapsGet but uses ctx for the underlying API calls.
+func (api *API) MapsGetContext(ctx context.Context, params Params) (res Maps, err error) {
+	for _, key := range []string{"output", "selectSelements", "selectLinks", "selectShapes", "selectLines",
+		"selectUrls", "selectUsers", "selectUserGroups"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "map.get", params, &res)
+	return
+}
+
+// MapGetByID Gets map by ID only if there is exactly 1 matching map.
+func (api *API) MapGetByID(id string) (res *Map, err error) {
+	return api.MapGetByIDContext(context.Background(), id)
+}
+
+// MapGetByIDContext is like MapGetByID but uses ctx for the underlying API calls.
+func (api *API) MapGetByIDContext(ctx context.Context, id string) (res *Map, err error) {
+	maps, err := api.MapsGetContext(ctx, Params{"sysmapids": id})
+	if err != nil {
+		return
+	}
+
+	if len(maps) == 1 {
+		res = &maps[0]
+	} else {
+		e := ExpectedOneResult(len(maps))
+		err = &e
+	}
+	return
+}
+
+// MapsCreate Wrapper for map.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/create
+func (api *API) MapsCreate(maps Maps) (err error) {
+	return api.MapsCreateContext(context.Background(), maps)
+}
+
+// MapsCreateContext is like MapsCreate but uses ctx for the underlying API calls.
+func (api *API) MapsCreateContext(ctx context.Context, maps Maps) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "map.create", maps)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	sysmapids := result["sysmapids"].([]interface{})
+	for i, id := range sysmapids {
+		maps[i].MapID = id.(string)
+	}
+	return
+}
+
+// MapsUpdate Wrapper for map.update
+// Elements, links, shapes, lines, URLs and sharing replace the existing ones when set.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/update
+func (api *API) MapsUpdate(maps Maps) (err error) {
+	return api.MapsUpdateContext(context.Background(), maps)
+}
+
+// MapsUpdateContext is like MapsUpdate but uses ctx for the underlying API calls.
+func (api *API) MapsUpdateContext(ctx context.Context, maps Maps) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "map.update", maps)
+	return
+}
+
+// MapsDelete Wrapper for map.delete
+// Cleans MapID in all maps elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/delete
+func (api *API) MapsDelete(maps Maps) (err error) {
+	return api.MapsDeleteContext(context.Background(), maps)
+}
+
+// MapsDeleteContext is like MapsDelete but uses ctx for the underlying API calls.
+func (api *API) MapsDeleteContext(ctx context.Context, maps Maps) (err error) {
+	ids := make([]string, len(maps))
+	for i, m := range maps {
+		ids[i] = m.MapID
+	}
+
+	err = api.MapsDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range maps {
+			maps[i].MapID = ""
+		}
+	}
+	return
+}
+
+// MapsDeleteByIds Wrapper for map.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/map/delete
+func (api *API) MapsDeleteByIds(ids []string) (err error) {
+	return api.MapsDeleteByIdsContext(context.Background(), ids)
+}
+
+// MapsDeleteByIdsContext is like MapsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) MapsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "map.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	sysmapids := result["sysmapids"].([]interface{})
+	if len(ids) != len(sysmapids) {
+		err = &ExpectedMore{len(ids), len(sysmapids)}
+	}
+	return
+}
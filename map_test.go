@@ -0,0 +1,109 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestMapsGet(t *testing.T) {
+	api := getAPI(t)
+
+	maps, err := api.MapsGet(zapi.Params{})
+	if err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+	if len(maps) == 0 {
+		return
+	}
+
+	m, err := api.MapGetByID(maps[0].MapID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != maps[0].Name {
+		t.Fatalf("unexpected map name: got %s want %s", m.Name, maps[0].Name)
+	}
+}
+
+func TestMapHostGrid(t *testing.T) {
+	m := zapi.Map{Name: "Site", Width: 200, Height: 800}
+	m.Elements = zapi.MapElements{{ElementID: "7", ElementType: zapi.MapElementImage, IconIDOff: "1"}}
+
+	hosts := zapi.Hosts{
+		{HostID: "1", Host: "a", Name: "Router"},
+		{HostID: "2", Host: "b"},
+		{HostID: "3", Host: "c"},
+		{HostID: "4", Host: "d"},
+		{HostID: "5", Host: "e"},
+	}
+	ids := m.AddHostGrid(hosts, zapi.MapGrid{IconID: "151", X: 50, Y: 20})
+	if len(ids) != 5 || ids[0] != "8" || ids[4] != "12" {
+		t.Fatalf("unexpected element ids %v", ids)
+	}
+	if len(m.Elements) != 6 {
+		t.Fatalf("unexpected elements %#v", m.Elements)
+	}
+
+	// 5 hosts fill a 3 columns square, the last one is on the second row
+	first, last := m.Elements[1], m.Elements[5]
+	if first.Label != "Router" || first.X != 50 || first.Y != 20 || first.Elements[0].HostID != "1" {
+		t.Errorf("unexpected first element %#v", first)
+	}
+	if last.Label != "e" || last.X != 150 || last.Y != 120 || last.IconIDOff != "151" {
+		t.Errorf("unexpected last element %#v", last)
+	}
+	if m.Width != 350 || m.Height != 800 {
+		t.Errorf("unexpected map size %dx%d", m.Width, m.Height)
+	}
+}
+
+func TestMapsFake(t *testing.T) {
+	api, _ := getFakeAPI(t)
+
+	m := zapi.Map{Name: "Site A", Private: zapi.MapPrivate, SeverityMin: zapi.Warning}
+	ids := m.AddHostGrid(zapi.Hosts{{HostID: "10", Name: "core"}, {HostID: "11", Name: "edge"}}, zapi.MapGrid{IconID: "2", Columns: 2})
+	m.Links = zapi.MapLinks{{
+		ElementID1: ids[0],
+		ElementID2: ids[1],
+		DrawType:   zapi.MapDrawBold,
+		Triggers:   zapi.MapLinkTriggers{{TriggerID: "99", Color: "DD0000", DrawType: zapi.MapDrawDashed}},
+	}}
+	m.Shapes = zapi.MapShapes{{Type: zapi.MapShapeRectangle, Width: 200, Height: 30, Text: "{MAP.NAME}"}}
+	m.Lines = zapi.MapLines{{X2: 200, LineType: zapi.MapLineSolid}}
+	m.URLs = zapi.MapURLs{{Name: "Inventory", URL: "https://cmdb/{HOST.NAME}", ElementType: zapi.MapElementHost}}
+	m.UserGroups = zapi.MapUserGroups{{UserGroupID: "7", Permission: zapi.MapReadOnly}}
+
+	maps := zapi.Maps{m}
+	if err := api.MapsCreate(maps); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := api.MapGetByID(maps[0].MapID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Width != 200 || got.Height != 100 || got.SeverityMin != zapi.Warning || got.Private != zapi.MapPrivate {
+		t.Fatalf("unexpected map %#v", got)
+	}
+	if len(got.Elements) != 2 || got.Elements[1].X != 100 || got.Elements[1].Elements[0].HostID != "11" {
+		t.Errorf("unexpected elements %#v", got.Elements)
+	}
+	if len(got.Links) != 1 || len(got.Links[0].Triggers) != 1 || got.Links[0].Triggers[0].DrawType != zapi.MapDrawDashed {
+		t.Errorf("unexpected links %#v", got.Links)
+	}
+	if len(got.Shapes) != 1 || len(got.Lines) != 1 || len(got.URLs) != 1 || len(got.UserGroups) != 1 {
+		t.Errorf("unexpected map %#v", got)
+	}
+
+	if err := api.MapsCreate(zapi.Maps{{Name: "Site A", Width: 100, Height: 100}}); err == nil {
+		t.Error("expected duplicate map name to fail")
+	}
+	if err := api.MapsDelete(maps); err != nil {
+		t.Fatal(err)
+	}
+	if maps[0].MapID != "" {
+		t.Error("map id was not cleared after delete")
+	}
+}
@@ -0,0 +1,336 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type (
+	// MediaTypeType transport used by the media type
+	// see "type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object
+	MediaTypeType int
+
+	// MediaTypeStatusType status of the media type
+	// see "status" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object
+	MediaTypeStatusType int
+
+	// SMTPSecurityType SMTP connection security
+	// see "smtp_security" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object
+	SMTPSecurityType int
+
+	// SMTPAuthenticationType SMTP authentication method
+	// see "smtp_authentication" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object
+	SMTPAuthenticationType int
+
+	// MediaTypeMessageFormat format of email messages
+	// see "message_format" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object
+	MediaTypeMessageFormat int
+
+	// MediaTypeRecoveryType operation mode of a message template
+	// see "recovery" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object#message-template
+	MediaTypeRecoveryType int
+)
+
+const (
+	// MediaTypeEmail email
+	MediaTypeEmail MediaTypeType = 0
+	// MediaTypeScript script
+	MediaTypeScript MediaTypeType = 1
+	// MediaTypeSMS SMS
+	MediaTypeSMS MediaTypeType = 2
+	// MediaTypeWebhook webhook
+	MediaTypeWebhook MediaTypeType = 4
+)
+
+const (
+	// MediaTypeEnabled media type is enabled
+	MediaTypeEnabled MediaTypeStatusType = 0
+	// MediaTypeDisabled media type is disabled
+	MediaTypeDisabled MediaTypeStatusType = 1
+)
+
+const (
+	// SMTPSecurityNone no encryption
+	SMTPSecurityNone SMTPSecurityType = 0
+	// SMTPSecuritySTARTTLS STARTTLS
+	SMTPSecuritySTARTTLS SMTPSecurityType = 1
+	// SMTPSecuritySSL SSL/TLS
+	SMTPSecuritySSL SMTPSecurityType = 2
+)
+
+const (
+	// SMTPAuthenticationNone no authentication
+	SMTPAuthenticationNone SMTPAuthenticationType = 0
+	// SMTPAuthenticationPassword username and password
+	SMTPAuthenticationPassword SMTPAuthenticationType = 1
+)
+
+const (
+	// MediaTypeFormatText plain text
+	MediaTypeFormatText MediaTypeMessageFormat = 0
+	// MediaTypeFormatHTML HTML
+	MediaTypeFormatHTML MediaTypeMessageFormat = 1
+)
+
+const (
+	// MediaTypeRecoveryOperations problem operations
+	MediaTypeRecoveryOperations MediaTypeRecoveryType = 0
+	// MediaTypeRecoveryRecovery recovery operations
+	MediaTypeRecoveryRecovery MediaTypeRecoveryType = 1
+	// MediaTypeRecoveryUpdate update operations
+	MediaTypeRecoveryUpdate MediaTypeRecoveryType = 2
+)
+
+// MediaTypeParameter webhook parameter
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object#webhook-parameters
+type MediaTypeParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// MediaTypeScriptParameter script parameter, passed to the script in sortorder
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object#script-parameters
+type MediaTypeScriptParameter struct {
+	SortOrder int    `json:"sortorder,string"`
+	Value     string `json:"value"`
+}
+
+// MediaTypeMessageTemplate default message of the media type for an event source
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object#message-template
+type MediaTypeMessageTemplate struct {
+	EventSource ActionEventSourceType `json:"eventsource,string"`
+	Recovery    MediaTypeRecoveryType `json:"recovery,string"`
+	Subject     string                `json:"subject,omitempty"`
+	Message     string                `json:"message,omitempty"`
+}
+
+// MediaType represent Zabbix media type object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/object
+type MediaType struct {
+	MediaTypeID     string              `json:"mediatypeid,omitempty"`
+	Name            string              `json:"name"`
+	Type            MediaTypeType       `json:"type,string"`
+	Status          MediaTypeStatusType `json:"status,string"`
+	Description     string              `json:"description,omitempty"`
+	MaxSessions     string              `json:"maxsessions,omitempty"`
+	MaxAttempts     string              `json:"maxattempts,omitempty"`
+	AttemptInterval string              `json:"attempt_interval,omitempty"`
+
+	// Email
+	SMTPServer         string                 `json:"smtp_server,omitempty"`
+	SMTPPort           string                 `json:"smtp_port,omitempty"`
+	SMTPHelo           string                 `json:"smtp_helo,omitempty"`
+	SMTPEmail          string                 `json:"smtp_email,omitempty"`
+	SMTPSecurity       SMTPSecurityType       `json:"smtp_security,omitempty,string"`
+	SMTPVerifyHost     string                 `json:"smtp_verify_host,omitempty"`
+	SMTPVerifyPeer     string                 `json:"smtp_verify_peer,omitempty"`
+	SMTPAuthentication SMTPAuthenticationType `json:"smtp_authentication,omitempty,string"`
+	Username           string                 `json:"username,omitempty"`
+	Password           string                 `json:"passwd,omitempty"`
+	MessageFormat      MediaTypeMessageFormat `json:"message_format,omitempty,string"`
+
+	// Script
+	ExecPath string `json:"exec_path,omitempty"`
+
+	// SMS
+	GSMModem string `json:"gsm_modem,omitempty"`
+
+	// Webhook
+	Script        string `json:"script,omitempty"`
+	Timeout       string `json:"timeout,omitempty"`
+	ProcessTags   string `json:"process_tags,omitempty"`
+	ShowEventMenu string `json:"show_event_menu,omitempty"`
+	EventMenuURL  string `json:"event_menu_url,omitempty"`
+	EventMenuName string `json:"event_menu_name,omitempty"`
+
+	// Parameters of webhook media types
+	Parameters []MediaTypeParameter `json:"-"`
+	// ScriptParameters of script media types
+	ScriptParameters []MediaTypeScriptParameter `json:"-"`
+	RawParameters    json.RawMessage            `json:"parameters,omitempty"`
+
+	MessageTemplates []MediaTypeMessageTemplate `json:"message_templates,omitempty"`
+}
+
+// MarshalJSON always sends SMTPSecurity, SMTPAuthentication and MessageFormat of email media types,
+// whose zero values (no encryption, no authentication, plain text) differ from the server defaults.
+// Other media types leave them out, as Zabbix only accepts their defaults there.
+func (m MediaType) MarshalJSON() ([]byte, error) {
+	type plain MediaType
+	if m.Type != MediaTypeEmail {
+		return json.Marshal(plain(m))
+	}
+	return json.Marshal(struct {
+		plain
+		SMTPSecurity       SMTPSecurityType       `json:"smtp_security,string"`
+		SMTPAuthentication SMTPAuthenticationType `json:"smtp_authentication,string"`
+		MessageFormat      MediaTypeMessageFormat `json:"message_format,string"`
+	}{plain(m), m.SMTPSecurity, m.SMTPAuthentication, m.MessageFormat})
+}
+
+// MediaTypes is an array of MediaType
+type MediaTypes []MediaType
+
+func prepMediaTypes(mediaTypes MediaTypes) (err error) {
+	for i, m := range mediaTypes {
+		var raw []byte
+		switch {
+		case m.Type == MediaTypeScript && m.ScriptParameters != nil:
+			raw, err = json.Marshal(m.ScriptParameters)
+		case m.Type == MediaTypeWebhook && m.Parameters != nil:
+			raw, err = json.Marshal(m.Parameters)
+		default:
+			mediaTypes[i].RawParameters = nil
+			continue
+		}
+		if err != nil {
+			return
+		}
+		mediaTypes[i].RawParameters = raw
+	}
+	return
+}
+
+func (api *API) mediaTypesParametersUnmarshal(mediaTypes MediaTypes) error {
+	for i, m := range mediaTypes {
+		if len(m.RawParameters) == 0 {
+			continue
+		}
+
+		var err error
+		switch m.Type {
+		case MediaTypeScript:
+			err = json.Unmarshal(m.RawParameters, &mediaTypes[i].ScriptParameters)
+		case MediaTypeWebhook:
+			err = json.Unmarshal(m.RawParameters, &mediaTypes[i].Parameters)
+		}
+		if err != nil {
+			api.printf("got error during unmarshal %s", err)
+			return fmt.Errorf("unmarshal media type parameters: %w", err)
+		}
+	}
+	return nil
+}
+
+// MediaTypesGet Wrapper for mediatype.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/get
+func (api *API) MediaTypesGet(params Params) (res MediaTypes, err error) {
+	return api.MediaTypesGetContext(context.Background(), params)
+}
+
+// MediaTypesGetContext is like MediaTypesGet but uses ctx for the underlying API calls.
+func (api *API) MediaTypesGetContext(ctx context.Context, params Params) (res MediaTypes, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	if err = api.CallWithErrorParseContext(ctx, "mediatype.get", params, &res); err != nil {
+		return
+	}
+	err = api.mediaTypesParametersUnmarshal(res)
+	return
+}
+
+// MediaTypeGetByID Gets media type by ID only if there is exactly 1 matching media type.
+func (api *API) MediaTypeGetByID(id string) (res *MediaType, err error) {
+	return api.MediaTypeGetByIDContext(context.Background(), id)
+}
+
+// MediaTypeGetByIDContext is like MediaTypeGetByID but uses ctx for the underlying API calls.
+func (api *API) MediaTypeGetByIDContext(ctx context.Context, id string) (res *MediaType, err error) {
+	mediaTypes, err := api.MediaTypesGetContext(ctx, Params{"mediatypeids": id, "selectMessageTemplates": "extend"})
+	if err != nil {
+		return
+	}
+
+	if len(mediaTypes) == 1 {
+		res = &mediaTypes[0]
+	} else {
+		e := ExpectedOneResult(len(mediaTypes))
+		err = &e
+	}
+	return
+}
+
+// MediaTypesCreate Wrapper for mediatype.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/create
+func (api *API) MediaTypesCreate(mediaTypes MediaTypes) (err error) {
+	return api.MediaTypesCreateContext(context.Background(), mediaTypes)
+}
+
+// MediaTypesCreateContext is like MediaTypesCreate but uses ctx for the underlying API calls.
+func (api *API) MediaTypesCreateContext(ctx context.Context, mediaTypes MediaTypes) (err error) {
+	if err = prepMediaTypes(mediaTypes); err != nil {
+		return
+	}
+	response, err := api.CallWithErrorContext(ctx, "mediatype.create", mediaTypes)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	mediatypeids := result["mediatypeids"].([]interface{})
+	for i, id := range mediatypeids {
+		mediaTypes[i].MediaTypeID = id.(string)
+	}
+	return
+}
+
+// MediaTypesUpdate Wrapper for mediatype.update
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/update
+func (api *API) MediaTypesUpdate(mediaTypes MediaTypes) (err error) {
+	return api.MediaTypesUpdateContext(context.Background(), mediaTypes)
+}
+
+// MediaTypesUpdateContext is like MediaTypesUpdate but uses ctx for the underlying API calls.
+func (api *API) MediaTypesUpdateContext(ctx context.Context, mediaTypes MediaTypes) (err error) {
+	if err = prepMediaTypes(mediaTypes); err != nil {
+		return
+	}
+	_, err = api.CallWithErrorContext(ctx, "mediatype.update", mediaTypes)
+	return
+}
+
+// MediaTypesDelete Wrapper for mediatype.delete
+// Cleans MediaTypeID in all mediaTypes elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/delete
+func (api *API) MediaTypesDelete(mediaTypes MediaTypes) (err error) {
+	return api.MediaTypesDeleteContext(context.Background(), mediaTypes)
+}
+
+// MediaTypesDeleteContext is like MediaTypesDelete but uses ctx for the underlying API calls.
+func (api *API) MediaTypesDeleteContext(ctx context.Context, mediaTypes MediaTypes) (err error) {
+	ids := make([]string, len(mediaTypes))
+	for i, mediaType := range mediaTypes {
+		ids[i] = mediaType.MediaTypeID
+	}
+
+	err = api.MediaTypesDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range mediaTypes {
+			mediaTypes[i].MediaTypeID = ""
+		}
+	}
+	return
+}
+
+// MediaTypesDeleteByIds Wrapper for mediatype.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/mediatype/delete
+func (api *API) MediaTypesDeleteByIds(ids []string) (err error) {
+	return api.MediaTypesDeleteByIdsContext(context.Background(), ids)
+}
+
+// MediaTypesDeleteByIdsContext is like MediaTypesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) MediaTypesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "mediatype.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	mediatypeids := result["mediatypeids"].([]interface{})
+	if len(ids) != len(mediatypeids) {
+		err = &ExpectedMore{len(ids), len(mediatypeids)}
+	}
+	return
+}
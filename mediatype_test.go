@@ -0,0 +1,171 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestMediaTypesGet(t *testing.T) {
+	api := getAPI(t)
+
+	mediaTypes, err := api.MediaTypesGet(zapi.Params{"selectMessageTemplates": "extend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range mediaTypes {
+		if m.Type == zapi.MediaTypeWebhook && len(m.RawParameters) > 0 && m.Parameters == nil {
+			t.Errorf("webhook parameters not decoded for %s", m.Name)
+		}
+	}
+}
+
+func TestMediaTypesFake(t *testing.T) {
+	api, _ := getFakeAPI(t)
+
+	mediaTypes := zapi.MediaTypes{
+		{
+			Name:               "Email",
+			Type:               zapi.MediaTypeEmail,
+			SMTPServer:         "mail.example.com",
+			SMTPPort:           "587",
+			SMTPEmail:          "zabbix@example.com",
+			SMTPSecurity:       zapi.SMTPSecuritySTARTTLS,
+			SMTPAuthentication: zapi.SMTPAuthenticationPassword,
+			Username:           "zabbix",
+			Password:           "secret",
+			MessageFormat:      zapi.MediaTypeFormatHTML,
+			MessageTemplates: []zapi.MediaTypeMessageTemplate{
+				{EventSource: zapi.ActionEventSourceTrigger, Recovery: zapi.MediaTypeRecoveryOperations, Subject: "Problem: {EVENT.NAME}", Message: "{EVENT.NAME}"},
+			},
+		},
+		{
+			Name:       "Chat",
+			Type:       zapi.MediaTypeWebhook,
+			Script:     "return 'OK';",
+			Timeout:    "30s",
+			Parameters: []zapi.MediaTypeParameter{{Name: "to", Value: "{ALERT.SENDTO}"}, {Name: "message", Value: "{ALERT.MESSAGE}"}},
+		},
+		{
+			Name:             "Pager",
+			Type:             zapi.MediaTypeScript,
+			ExecPath:         "pager.sh",
+			ScriptParameters: []zapi.MediaTypeScriptParameter{{SortOrder: 0, Value: "{ALERT.SENDTO}"}, {SortOrder: 1, Value: "{ALERT.SUBJECT}"}},
+		},
+	}
+	if err := api.MediaTypesCreate(mediaTypes); err != nil {
+		t.Fatal(err)
+	}
+
+	webhook, err := api.MediaTypeGetByID(mediaTypes[1].MediaTypeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhook.Parameters) != 2 || webhook.Parameters[1].Name != "message" {
+		t.Errorf("unexpected webhook parameters %#v", webhook.Parameters)
+	}
+	script, err := api.MediaTypeGetByID(mediaTypes[2].MediaTypeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(script.ScriptParameters) != 2 || script.ScriptParameters[1].SortOrder != 1 {
+		t.Errorf("unexpected script parameters %#v", script.ScriptParameters)
+	}
+	email, err := api.MediaTypeGetByID(mediaTypes[0].MediaTypeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if email.SMTPSecurity != zapi.SMTPSecuritySTARTTLS || len(email.MessageTemplates) != 1 {
+		t.Errorf("unexpected email media type %#v", email)
+	}
+
+	users := zapi.Users{{
+		Username: "notified",
+		Password: "Secret-123",
+		RoleID:   "1",
+		Medias: zapi.UserMedias{
+			{MediaTypeID: email.MediaTypeID, SendTo: zapi.MediaSendTo{"a@example.com", "b@example.com"}, Severity: zapi.MediaSeverities(zapi.High, zapi.Critical), Period: zapi.MediaPeriodAlways},
+			{MediaTypeID: webhook.MediaTypeID, SendTo: zapi.MediaSendTo{"@oncall"}},
+		},
+	}}
+	if err = api.UsersCreate(users); err != nil {
+		t.Fatal(err)
+	}
+	got, err := api.UsersGet(zapi.Params{"userids": users[0].UserID, "selectMedias": "extend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || len(got[0].Medias) != 2 {
+		t.Fatalf("unexpected users %#v", got)
+	}
+	m := got[0].Medias[0]
+	if len(m.SendTo) != 2 || !m.Severity.Has(zapi.Critical) || m.Severity.Has(zapi.Warning) {
+		t.Errorf("unexpected media %#v", m)
+	}
+
+	if err = api.MediaTypesDelete(mediaTypes); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMediaTypeZeroValuesFake(t *testing.T) {
+	api, _ := getFakeAPI(t)
+
+	mediaTypes := zapi.MediaTypes{{
+		Name:               "Plain email",
+		Type:               zapi.MediaTypeEmail,
+		SMTPServer:         "mail.example.com",
+		SMTPEmail:          "zabbix@example.com",
+		SMTPSecurity:       zapi.SMTPSecuritySTARTTLS,
+		SMTPAuthentication: zapi.SMTPAuthenticationPassword,
+		MessageFormat:      zapi.MediaTypeFormatHTML,
+	}}
+	if err := api.MediaTypesCreate(mediaTypes); err != nil {
+		t.Fatal(err)
+	}
+
+	// turn off TLS and authentication and switch to plain text
+	mediaTypes[0].SMTPSecurity = zapi.SMTPSecurityNone
+	mediaTypes[0].SMTPAuthentication = zapi.SMTPAuthenticationNone
+	mediaTypes[0].MessageFormat = zapi.MediaTypeFormatText
+	if err := api.MediaTypesUpdate(mediaTypes); err != nil {
+		t.Fatal(err)
+	}
+	got, err := api.MediaTypeGetByID(mediaTypes[0].MediaTypeID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.SMTPSecurity != zapi.SMTPSecurityNone || got.SMTPAuthentication != zapi.SMTPAuthenticationNone ||
+		got.MessageFormat != zapi.MediaTypeFormatText {
+		t.Errorf("zero values not stored %#v", got)
+	}
+
+	b, err := json.Marshal(zapi.MediaType{Name: "Chat", Type: zapi.MediaTypeWebhook})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"smtp_security", "smtp_authentication", "message_format"} {
+		if _, ok := fields[name]; ok {
+			t.Errorf("email field %s sent for webhook %s", name, b)
+		}
+	}
+}
+
+func TestMediaSendToJSON(t *testing.T) {
+	b, _ := json.Marshal(zapi.MediaSendTo{"one"})
+	if string(b) != `"one"` {
+		t.Errorf("single recipient encoded as %s", b)
+	}
+	b, _ = json.Marshal(zapi.MediaSendTo{"one", "two"})
+	if string(b) != `["one","two"]` {
+		t.Errorf("several recipients encoded as %s", b)
+	}
+	if zapi.MediaSeverities(zapi.NotClassified, zapi.Critical) != 33 {
+		t.Error("unexpected severity bitmask")
+	}
+}
@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 // Proxy represent Zabbix proxy object
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/proxy/object#proxy
 type Proxy struct {
@@ -24,16 +26,26 @@ type Proxies []Proxy
 // ProxiesGet Wrapper for proxy.get
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/get
 func (api *API) ProxiesGet(params Params) (res Proxies, err error) {
+	return api.ProxiesGetContext(context.Background(), params)
+}
+
+// ProxiesGetContext is like ProxiesGet but uses ctx for the underlying API calls.
+func (api *API) ProxiesGetContext(ctx context.Context, params Params) (res Proxies, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("proxy.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "proxy.get", params, &res)
 	return
 }
 
 // ProxyGetByID Gets user by Id only if there is exactly 1 matching proxy.
 func (api *API) ProxyGetByID(id string) (res *Proxy, err error) {
-	groups, err := api.ProxiesGet(Params{"proxyids": id})
+	return api.ProxyGetByIDContext(context.Background(), id)
+}
+
+// ProxyGetByIDContext is like ProxyGetByID but uses ctx for the underlying API calls.
+func (api *API) ProxyGetByIDContext(ctx context.Context, id string) (res *Proxy, err error) {
+	groups, err := api.ProxiesGetContext(ctx, Params{"proxyids": id})
 	if err != nil {
 		return
 	}
@@ -50,7 +62,12 @@ func (api *API) ProxyGetByID(id string) (res *Proxy, err error) {
 // ProxiesCreate Wrapper for proxy.create
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/create
 func (api *API) ProxiesCreate(Proxies Proxies) (err error) {
-	response, err := api.CallWithError("proxy.create", Proxies)
+	return api.ProxiesCreateContext(context.Background(), Proxies)
+}
+
+// ProxiesCreateContext is like ProxiesCreate but uses ctx for the underlying API calls.
+func (api *API) ProxiesCreateContext(ctx context.Context, Proxies Proxies) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "proxy.create", Proxies)
 	if err != nil {
 		return
 	}
@@ -66,7 +83,12 @@ func (api *API) ProxiesCreate(Proxies Proxies) (err error) {
 // ProxiesUpdate Wrapper for proxy.update
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/update
 func (api *API) ProxiesUpdate(Proxies Proxies) (err error) {
-	_, err = api.CallWithError("proxy.update", Proxies)
+	return api.ProxiesUpdateContext(context.Background(), Proxies)
+}
+
+// ProxiesUpdateContext is like ProxiesUpdate but uses ctx for the underlying API calls.
+func (api *API) ProxiesUpdateContext(ctx context.Context, Proxies Proxies) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "proxy.update", Proxies)
 	return
 }
 
@@ -74,12 +96,17 @@ func (api *API) ProxiesUpdate(Proxies Proxies) (err error) {
 // Cleans ProxyID in all Proxies elements if call succeed.
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/delete
 func (api *API) ProxiesDelete(Proxies Proxies) (err error) {
+	return api.ProxiesDeleteContext(context.Background(), Proxies)
+}
+
+// ProxiesDeleteContext is like ProxiesDelete but uses ctx for the underlying API calls.
+func (api *API) ProxiesDeleteContext(ctx context.Context, Proxies Proxies) (err error) {
 	ids := make([]string, len(Proxies))
 	for i, proxy := range Proxies {
 		ids[i] = proxy.ProxyID
 	}
 
-	err = api.ProxiesDeleteByIds(ids)
+	err = api.ProxiesDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range Proxies {
 			Proxies[i].ProxyID = ""
@@ -91,7 +118,12 @@ func (api *API) ProxiesDelete(Proxies Proxies) (err error) {
 // ProxiesDeleteByIds Wrapper for proxy.delete
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/proxy/delete
 func (api *API) ProxiesDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("proxy.delete", ids)
+	return api.ProxiesDeleteByIdsContext(context.Background(), ids)
+}
+
+// ProxiesDeleteByIdsContext is like ProxiesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) ProxiesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "proxy.delete", ids)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,525 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Sort orders accepted by "sortorder".
+const (
+	SortAsc  = "ASC"
+	SortDesc = "DESC"
+)
+
+// querySpec fields and parameters accepted by a get method.
+type querySpec struct {
+	method string
+	// idsParam parameter filtering by primary key
+	idsParam string
+	// fields usable in output, filter and search
+	fields []string
+	// sortFields usable in sortfield
+	sortFields []string
+	// selects fields of the sub-objects returned by select* parameters taking a field list
+	selects map[string][]string
+}
+
+func (s *querySpec) hasField(f string) bool {
+	return containsString(s.fields, f)
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Querier is a typed query that compiles to the parameters of a get method.
+type Querier interface {
+	// Method returns the API method, like "host.get".
+	Method() string
+	// Params returns the compiled parameters or the validation errors.
+	Params() (Params, error)
+}
+
+// Query holds the parameters shared by the typed query builders HostQuery, ItemQuery
+// and TriggerQuery. Its methods return the embedding builder so calls can be chained;
+// field names are validated against the object of the get method when Params is called.
+type Query[Q any] struct {
+	self   *Q
+	spec   *querySpec
+	params Params
+	errs   []error
+}
+
+func (q *Query[Q]) init(self *Q, spec *querySpec) {
+	q.self = self
+	q.spec = spec
+	q.params = Params{}
+}
+
+func (q *Query[Q]) fail(format string, v ...interface{}) {
+	q.errs = append(q.errs, fmt.Errorf("%s: "+format, append([]interface{}{q.spec.method}, v...)...))
+}
+
+func (q *Query[Q]) checkFields(fields []string) bool {
+	ok := true
+	for _, f := range fields {
+		if !q.spec.hasField(f) {
+			q.fail("unknown field %q", f)
+			ok = false
+		}
+	}
+	return ok
+}
+
+func (q *Query[Q]) set(param string, value interface{}) *Q {
+	q.params[param] = value
+	return q.self
+}
+
+// setField sets param[field] to value, or to values when there are several.
+func (q *Query[Q]) setField(param, field string, values []interface{}) *Q {
+	if !q.checkFields([]string{field}) {
+		return q.self
+	}
+	m, _ := q.params[param].(map[string]interface{})
+	if m == nil {
+		m = map[string]interface{}{}
+		q.params[param] = m
+	}
+	if len(values) == 1 {
+		m[field] = values[0]
+	} else {
+		m[field] = values
+	}
+	return q.self
+}
+
+// selectFields sets a select* parameter to "extend" or to the given fields of its sub-object.
+func (q *Query[Q]) selectFields(param string, fields []string) *Q {
+	if len(fields) == 0 {
+		return q.set(param, "extend")
+	}
+	for _, f := range fields {
+		if !containsString(q.spec.selects[param], f) {
+			q.fail("unknown %s field %q", param, f)
+		}
+	}
+	return q.set(param, fields)
+}
+
+// Method returns the get method of the query.
+func (q *Query[Q]) Method() string {
+	return q.spec.method
+}
+
+// IDs returns only objects with the given primary keys.
+func (q *Query[Q]) IDs(ids ...string) *Q {
+	return q.set(q.spec.idsParam, ids)
+}
+
+// Filter returns only objects whose field exactly matches one of values.
+func (q *Query[Q]) Filter(field string, values ...interface{}) *Q {
+	if len(values) == 0 {
+		q.fail("filter on %q without values", field)
+		return q.self
+	}
+	return q.setField("filter", field, values)
+}
+
+// Search returns only objects whose field contains one of values.
+func (q *Query[Q]) Search(field string, values ...string) *Q {
+	if len(values) == 0 {
+		q.fail("search on %q without values", field)
+		return q.self
+	}
+	vs := make([]interface{}, len(values))
+	for i, v := range values {
+		vs[i] = v
+	}
+	return q.setField("search", field, vs)
+}
+
+// SearchWildcards enables "*" wildcards in Search values.
+func (q *Query[Q]) SearchWildcards() *Q {
+	return q.set("searchWildcardsEnabled", true)
+}
+
+// SearchByAny returns objects matching any Search condition instead of all of them.
+func (q *Query[Q]) SearchByAny() *Q {
+	return q.set("searchByAny", true)
+}
+
+// StartSearch matches Search values at the beginning of fields only.
+func (q *Query[Q]) StartSearch() *Q {
+	return q.set("startSearch", true)
+}
+
+// Output returns only the given fields; the default is all fields.
+func (q *Query[Q]) Output(fields ...string) *Q {
+	q.checkFields(fields)
+	return q.set("output", fields)
+}
+
+// SortField sorts the result by the given fields.
+func (q *Query[Q]) SortField(fields ...string) *Q {
+	for _, f := range fields {
+		if !containsString(q.spec.sortFields, f) {
+			q.fail("cannot sort by %q", f)
+		}
+	}
+	return q.set("sortfield", fields)
+}
+
+// SortOrder sets SortAsc or SortDesc.
+func (q *Query[Q]) SortOrder(order string) *Q {
+	if order != SortAsc && order != SortDesc {
+		q.fail("unknown sort order %q", order)
+	}
+	return q.set("sortorder", order)
+}
+
+// Limit limits the number of returned objects.
+func (q *Query[Q]) Limit(n int) *Q {
+	if n <= 0 {
+		q.fail("limit must be positive, got %d", n)
+	}
+	return q.set("limit", n)
+}
+
+// CountOutput returns the number of matching objects instead of the objects; it can not be
+// combined with Output or Select* methods. Run such queries with API.Count.
+func (q *Query[Q]) CountOutput() *Q {
+	return q.set("countOutput", true)
+}
+
+// Params returns the compiled parameters, or the validation errors of the query.
+func (q *Query[Q]) Params() (Params, error) {
+	errs := q.errs
+	if _, ok := q.params["countOutput"]; ok {
+		var conflicts []string
+		for k := range q.params {
+			if k == "output" || strings.HasPrefix(k, "select") {
+				conflicts = append(conflicts, k)
+			}
+		}
+		if len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			errs = append(errs, fmt.Errorf("%s: countOutput can not be combined with %s",
+				q.spec.method, strings.Join(conflicts, ", ")))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	res := make(Params, len(q.params))
+	for k, v := range q.params {
+		res[k] = v
+	}
+	return res, nil
+}
+
+// HostQuery typed parameters of host.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/host/get
+type HostQuery struct {
+	Query[HostQuery]
+}
+
+var (
+	hostFields = []string{"hostid", "host", "name", "description", "status", "flags", "inventory_mode",
+		"monitored_by", "proxyid", "proxy_groupid", "assigned_proxyid", "maintenance_status", "maintenance_type",
+		"maintenanceid", "maintenance_from", "ipmi_authtype", "ipmi_privilege", "ipmi_username", "ipmi_password",
+		"tls_connect", "tls_accept", "tls_issuer", "tls_subject", "active_available", "uuid"}
+	itemFields = []string{"itemid", "hostid", "interfaceid", "name", "key_", "type", "value_type", "delay", "history",
+		"trends", "status", "state", "error", "description", "units", "flags", "templateid", "valuemapid",
+		"master_itemid", "params", "trapper_hosts", "url", "request_method", "post_type", "posts", "query_fields",
+		"status_codes", "timeout", "follow_redirects", "retrieve_mode", "output_format", "http_proxy",
+		"authtype", "username", "password", "verify_host", "verify_peer", "allow_traps", "snmp_oid",
+		"ipmi_sensor", "jmx_endpoint", "logtimefmt", "publickey", "privatekey", "inventory_link",
+		"lastclock", "lastns", "lastvalue", "prevvalue", "uuid"}
+	triggerFields = []string{"triggerid", "description", "expression", "event_name", "opdata", "comments", "error",
+		"flags", "lastchange", "priority", "state", "status", "templateid", "type", "url", "url_name", "value",
+		"recovery_mode", "recovery_expression", "correlation_mode", "correlation_tag", "manual_close", "uuid"}
+	hostInterfaceFields = []string{"interfaceid", "hostid", "type", "ip", "dns", "port", "useip", "main",
+		"available", "error", "errors_from", "disable_until", "details"}
+	hostGroupFields = []string{"groupid", "name", "flags", "uuid"}
+	templateFields  = []string{"templateid", "host", "name", "description", "uuid", "vendor_name", "vendor_version"}
+	inventoryFields = []string{"type", "type_full", "name", "alias", "os", "os_full", "os_short", "serialno_a",
+		"serialno_b", "tag", "asset_tag", "macaddress_a", "macaddress_b", "hardware", "hardware_full", "software",
+		"software_full", "software_app_a", "software_app_b", "software_app_c", "software_app_d", "software_app_e",
+		"contact", "location", "location_lat", "location_lon", "notes", "chassis", "model", "hw_arch", "vendor",
+		"contract_number", "installer_name", "deployment_status", "url_a", "url_b", "url_c", "host_networks",
+		"host_netmask", "host_router", "oob_ip", "oob_netmask", "oob_router", "date_hw_purchase", "date_hw_install",
+		"date_hw_expiry", "date_hw_decomm", "site_address_a", "site_address_b", "site_address_c", "site_city",
+		"site_state", "site_country", "site_zip", "site_rack", "site_notes", "poc_1_name", "poc_1_email",
+		"poc_1_phone_a", "poc_1_phone_b", "poc_1_cell", "poc_1_screen", "poc_1_notes", "poc_2_name", "poc_2_email",
+		"poc_2_phone_a", "poc_2_phone_b", "poc_2_cell", "poc_2_screen", "poc_2_notes"}
+)
+
+var hostQuerySpec = &querySpec{
+	method:     "host.get",
+	idsParam:   "hostids",
+	fields:     hostFields,
+	sortFields: []string{"hostid", "host", "name", "status"},
+	selects: map[string][]string{
+		"selectInterfaces":      hostInterfaceFields,
+		"selectHostGroups":      hostGroupFields,
+		"selectParentTemplates": templateFields,
+		"selectInventory":       inventoryFields,
+	},
+}
+
+// NewHostQuery starts a host.get query.
+func NewHostQuery() *HostQuery {
+	q := &HostQuery{}
+	q.init(q, hostQuerySpec)
+	return q
+}
+
+// GroupIDs returns only hosts in the given host groups.
+func (q *HostQuery) GroupIDs(ids ...string) *HostQuery { return q.set("groupids", ids) }
+
+// TemplateIDs returns only hosts linked to the given templates.
+func (q *HostQuery) TemplateIDs(ids ...string) *HostQuery { return q.set("templateids", ids) }
+
+// ProxyIDs returns only hosts monitored by the given proxies.
+func (q *HostQuery) ProxyIDs(ids ...string) *HostQuery { return q.set("proxyids", ids) }
+
+// MonitoredHosts returns only monitored hosts.
+func (q *HostQuery) MonitoredHosts() *HostQuery { return q.set("monitored_hosts", true) }
+
+// SelectInterfaces returns host interfaces with the given fields, all when none.
+func (q *HostQuery) SelectInterfaces(fields ...string) *HostQuery {
+	return q.selectFields("selectInterfaces", fields)
+}
+
+// SelectTags returns host tags.
+func (q *HostQuery) SelectTags() *HostQuery { return q.selectFields("selectTags", nil) }
+
+// SelectHostGroups returns host groups with the given fields, all when none.
+func (q *HostQuery) SelectHostGroups(fields ...string) *HostQuery {
+	return q.selectFields("selectHostGroups", fields)
+}
+
+// SelectParentTemplates returns linked templates with the given fields, all when none.
+func (q *HostQuery) SelectParentTemplates(fields ...string) *HostQuery {
+	return q.selectFields("selectParentTemplates", fields)
+}
+
+// SelectMacros returns host macros.
+func (q *HostQuery) SelectMacros() *HostQuery { return q.selectFields("selectMacros", nil) }
+
+// SelectInventory returns host inventory with the given fields, all when none.
+func (q *HostQuery) SelectInventory(fields ...string) *HostQuery {
+	return q.selectFields("selectInventory", fields)
+}
+
+// ItemQuery typed parameters of item.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/item/get
+type ItemQuery struct {
+	Query[ItemQuery]
+}
+
+var itemQuerySpec = &querySpec{
+	method:     "item.get",
+	idsParam:   "itemids",
+	fields:     itemFields,
+	sortFields: []string{"itemid", "name", "key_", "delay", "history", "trends", "type", "status"},
+	selects: map[string][]string{
+		"selectHosts":    hostFields,
+		"selectTriggers": triggerFields,
+	},
+}
+
+// NewItemQuery starts an item.get query.
+func NewItemQuery() *ItemQuery {
+	q := &ItemQuery{}
+	q.init(q, itemQuerySpec)
+	return q
+}
+
+// GroupIDs returns only items of hosts in the given host groups.
+func (q *ItemQuery) GroupIDs(ids ...string) *ItemQuery { return q.set("groupids", ids) }
+
+// HostIDs returns only items of the given hosts.
+func (q *ItemQuery) HostIDs(ids ...string) *ItemQuery { return q.set("hostids", ids) }
+
+// TemplateIDs returns only items of the given templates.
+func (q *ItemQuery) TemplateIDs(ids ...string) *ItemQuery { return q.set("templateids", ids) }
+
+// TriggerIDs returns only items used in the given triggers.
+func (q *ItemQuery) TriggerIDs(ids ...string) *ItemQuery { return q.set("triggerids", ids) }
+
+// Monitored returns only enabled items of monitored hosts.
+func (q *ItemQuery) Monitored() *ItemQuery { return q.set("monitored", true) }
+
+// SelectHosts returns the hosts of the items with the given fields, all when none.
+func (q *ItemQuery) SelectHosts(fields ...string) *ItemQuery {
+	return q.selectFields("selectHosts", fields)
+}
+
+// SelectTags returns item tags.
+func (q *ItemQuery) SelectTags() *ItemQuery { return q.selectFields("selectTags", nil) }
+
+// SelectPreprocessing returns item preprocessing steps.
+func (q *ItemQuery) SelectPreprocessing() *ItemQuery {
+	return q.selectFields("selectPreprocessing", nil)
+}
+
+// SelectTriggers returns the triggers using the items with the given fields, all when none.
+func (q *ItemQuery) SelectTriggers(fields ...string) *ItemQuery {
+	return q.selectFields("selectTriggers", fields)
+}
+
+// TriggerQuery typed parameters of trigger.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/trigger/get
+type TriggerQuery struct {
+	Query[TriggerQuery]
+}
+
+var triggerQuerySpec = &querySpec{
+	method:     "trigger.get",
+	idsParam:   "triggerids",
+	fields:     triggerFields,
+	sortFields: []string{"triggerid", "description", "status", "priority", "lastchange", "hostname"},
+	selects: map[string][]string{
+		"selectHosts": hostFields,
+		"selectItems": itemFields,
+	},
+}
+
+// NewTriggerQuery starts a trigger.get query.
+func NewTriggerQuery() *TriggerQuery {
+	q := &TriggerQuery{}
+	q.init(q, triggerQuerySpec)
+	return q
+}
+
+// GroupIDs returns only triggers of hosts in the given host groups.
+func (q *TriggerQuery) GroupIDs(ids ...string) *TriggerQuery { return q.set("groupids", ids) }
+
+// HostIDs returns only triggers of the given hosts.
+func (q *TriggerQuery) HostIDs(ids ...string) *TriggerQuery { return q.set("hostids", ids) }
+
+// TemplateIDs returns only triggers of the given templates.
+func (q *TriggerQuery) TemplateIDs(ids ...string) *TriggerQuery { return q.set("templateids", ids) }
+
+// ItemIDs returns only triggers using the given items.
+func (q *TriggerQuery) ItemIDs(ids ...string) *TriggerQuery { return q.set("itemids", ids) }
+
+// Monitored returns only enabled triggers of monitored hosts with enabled items.
+func (q *TriggerQuery) Monitored() *TriggerQuery { return q.set("monitored", true) }
+
+// OnlyTrue returns only triggers that recently were in problem state.
+func (q *TriggerQuery) OnlyTrue() *TriggerQuery { return q.set("only_true", true) }
+
+// MinSeverity returns only triggers with at least the given severity.
+func (q *TriggerQuery) MinSeverity(s SeverityType) *TriggerQuery { return q.set("min_severity", s) }
+
+// SelectHosts returns the hosts of the triggers with the given fields, all when none.
+func (q *TriggerQuery) SelectHosts(fields ...string) *TriggerQuery {
+	return q.selectFields("selectHosts", fields)
+}
+
+// SelectItems returns the items used by the triggers with the given fields, all when none.
+func (q *TriggerQuery) SelectItems(fields ...string) *TriggerQuery {
+	return q.selectFields("selectItems", fields)
+}
+
+// SelectFunctions returns the functions of the trigger expressions.
+func (q *TriggerQuery) SelectFunctions() *TriggerQuery {
+	return q.selectFields("selectFunctions", nil)
+}
+
+// SelectDependencies returns the triggers the triggers depend on.
+func (q *TriggerQuery) SelectDependencies() *TriggerQuery {
+	return q.selectFields("selectDependencies", nil)
+}
+
+// SelectTags returns trigger tags.
+func (q *TriggerQuery) SelectTags() *TriggerQuery { return q.selectFields("selectTags", nil) }
+
+// objectParams returns the parameters of q for the wrappers decoding objects,
+// which can not decode the number returned with countOutput.
+func objectParams(q Querier) (params Params, err error) {
+	if params, err = q.Params(); err != nil {
+		return
+	}
+	if _, ok := params["countOutput"]; ok {
+		params, err = nil, fmt.Errorf("%s: countOutput returns a number, use API.Count", q.Method())
+	}
+	return
+}
+
+// HostsQuery Wrapper for host.get with a typed query.
+func (api *API) HostsQuery(q *HostQuery) (res Hosts, err error) {
+	return api.HostsQueryContext(context.Background(), q)
+}
+
+// HostsQueryContext is like HostsQuery but uses ctx for the underlying API calls.
+func (api *API) HostsQueryContext(ctx context.Context, q *HostQuery) (res Hosts, err error) {
+	params, err := objectParams(q)
+	if err != nil {
+		return
+	}
+	return api.HostsGetContext(ctx, params)
+}
+
+// ItemsQuery Wrapper for item.get with a typed query.
+func (api *API) ItemsQuery(q *ItemQuery) (res Items, err error) {
+	return api.ItemsQueryContext(context.Background(), q)
+}
+
+// ItemsQueryContext is like ItemsQuery but uses ctx for the underlying API calls.
+func (api *API) ItemsQueryContext(ctx context.Context, q *ItemQuery) (res Items, err error) {
+	params, err := objectParams(q)
+	if err != nil {
+		return
+	}
+	return api.ItemsGetContext(ctx, params)
+}
+
+// TriggersQuery Wrapper for trigger.get with a typed query.
+func (api *API) TriggersQuery(q *TriggerQuery) (res Triggers, err error) {
+	return api.TriggersQueryContext(context.Background(), q)
+}
+
+// TriggersQueryContext is like TriggersQuery but uses ctx for the underlying API calls.
+func (api *API) TriggersQueryContext(ctx context.Context, q *TriggerQuery) (res Triggers, err error) {
+	params, err := objectParams(q)
+	if err != nil {
+		return
+	}
+	return api.TriggersGetContext(ctx, params)
+}
+
+// Count returns the number of objects matching q, sending it with countOutput
+// whether or not q has CountOutput set.
+func (api *API) Count(q Querier) (int, error) {
+	return api.CountContext(context.Background(), q)
+}
+
+// CountContext is like Count but uses ctx for the underlying API calls.
+func (api *API) CountContext(ctx context.Context, q Querier) (n int, err error) {
+	params, err := q.Params()
+	if err != nil {
+		return
+	}
+	params["countOutput"] = true
+	delete(params, "output")
+
+	var res json.Number
+	if err = api.CallWithErrorParseContext(ctx, q.Method(), params, &res); err != nil {
+		return
+	}
+	return strconv.Atoi(res.String())
+}
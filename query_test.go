@@ -0,0 +1,132 @@
+package zabbix_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestHostQueryParams(t *testing.T) {
+	params, err := zapi.NewHostQuery().
+		IDs("1", "2").
+		GroupIDs("4").
+		Filter("status", zapi.Monitored).
+		Search("name", "web*").
+		SearchWildcards().
+		SelectInterfaces().
+		SelectTags().
+		Output("host", "name").
+		SortField("name").
+		SortOrder(zapi.SortDesc).
+		Limit(10).
+		Params()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := zapi.Params{
+		"hostids":                []string{"1", "2"},
+		"groupids":               []string{"4"},
+		"filter":                 map[string]interface{}{"status": zapi.Monitored},
+		"search":                 map[string]interface{}{"name": "web*"},
+		"searchWildcardsEnabled": true,
+		"selectInterfaces":       "extend",
+		"selectTags":             "extend",
+		"output":                 []string{"host", "name"},
+		"sortfield":              []string{"name"},
+		"sortorder":              "DESC",
+		"limit":                  10,
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("unexpected params\n got %#v\nwant %#v", params, want)
+	}
+}
+
+func TestQueryValidation(t *testing.T) {
+	_, err := zapi.NewHostQuery().Filter("hostids", "1").Output("hostname").SortField("description").Params()
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, s := range []string{`"hostids"`, `"hostname"`, `"description"`} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("error %q does not mention %s", err, s)
+		}
+	}
+
+	if _, err = zapi.NewItemQuery().Search("key_").Params(); err == nil {
+		t.Error("expected error for search without values")
+	}
+	if _, err = zapi.NewTriggerQuery().SortOrder("down").Params(); err == nil {
+		t.Error("expected error for unknown sort order")
+	}
+	if _, err = zapi.NewTriggerQuery().Filter("priority", zapi.High, zapi.Critical).SortField("hostname").Params(); err != nil {
+		t.Error(err)
+	}
+
+	_, err = zapi.NewHostQuery().SelectInterfaces("ip", "adress").SelectInventory("os", "hw_arch").Params()
+	if err == nil || !strings.Contains(err.Error(), `selectInterfaces field "adress"`) {
+		t.Errorf("expected unknown interface field to fail, got %v", err)
+	}
+	if _, err = zapi.NewTriggerQuery().SelectItems("key_", "lastvalue").SelectHosts("host").Params(); err != nil {
+		t.Error(err)
+	}
+	if _, err = zapi.NewItemQuery().SelectTriggers("hostid").Params(); err == nil {
+		t.Error("expected unknown trigger field to fail")
+	}
+
+	_, err = zapi.NewHostQuery().CountOutput().Output("host").SelectTags().Params()
+	if err == nil || !strings.Contains(err.Error(), "output, selectTags") {
+		t.Errorf("expected countOutput with output and selectTags to fail, got %v", err)
+	}
+	params, err := zapi.NewHostQuery().GroupIDs("4").CountOutput().Params()
+	if err != nil || params["countOutput"] != true {
+		t.Errorf("unexpected count params %#v, %v", params, err)
+	}
+}
+
+func TestQueryFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	hostIDs := srv.Add("host",
+		map[string]interface{}{"host": "web01", "name": "Web 01", "status": 0},
+		map[string]interface{}{"host": "web02", "name": "Web 02", "status": 1},
+		map[string]interface{}{"host": "db01", "name": "DB 01", "status": 0},
+	)
+	srv.Add("item",
+		map[string]interface{}{"hostid": hostIDs[0], "key_": "agent.ping", "name": "Ping", "value_type": 3},
+		map[string]interface{}{"hostid": hostIDs[2], "key_": "agent.ping", "name": "Ping", "value_type": 3},
+	)
+
+	hosts, err := api.HostsQuery(zapi.NewHostQuery().Search("host", "web").Filter("status", zapi.Monitored))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hosts) != 1 || hosts[0].Host != "web01" {
+		t.Errorf("unexpected hosts %#v", hosts)
+	}
+
+	n, err := api.Count(zapi.NewItemQuery().HostIDs(hostIDs[0], hostIDs[1]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("expected 1 item, got %d", n)
+	}
+
+	n, err = api.Count(zapi.NewHostQuery().Filter("status", zapi.Monitored).CountOutput())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("expected 2 monitored hosts, got %d", n)
+	}
+
+	if _, err = api.ItemsQuery(zapi.NewItemQuery().Filter("hostids", hostIDs[0])); err == nil {
+		t.Error("expected invalid query to fail before sending")
+	}
+	if _, err = api.HostsQuery(zapi.NewHostQuery().CountOutput()); err == nil || !strings.Contains(err.Error(), "API.Count") {
+		t.Errorf("expected HostsQuery with countOutput to point to Count, got %v", err)
+	}
+}
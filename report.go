@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 type (
 	// ReportPeriodType period for which the report is prepared
 	// see "period" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/report/object
@@ -126,16 +128,26 @@ type Reports []Report
 // ReportsGet Wrapper for report.get
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/report/get
 func (api *API) ReportsGet(params Params) (res Reports, err error) {
+	return api.ReportsGetContext(context.Background(), params)
+}
+
+// ReportsGetContext is like ReportsGet but uses ctx for the underlying API calls.
+func (api *API) ReportsGetContext(ctx context.Context, params Params) (res Reports, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("report.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "report.get", params, &res)
 	return
 }
 
 // ReportGetByID Gets report by ID only if there is exactly 1 matching report.
 func (api *API) ReportGetByID(id string) (res *Report, err error) {
-	reports, err := api.ReportsGet(Params{"reportids": id})
+	return api.ReportGetByIDContext(context.Background(), id)
+}
+
+// ReportGetByIDContext is like ReportGetByID but uses ctx for the underlying API calls.
+func (api *API) ReportGetByIDContext(ctx context.Context, id string) (res *Report, err error) {
+	reports, err := api.ReportsGetContext(ctx, Params{"reportids": id})
 	if err != nil {
 		return
 	}
@@ -152,7 +164,12 @@ func (api *API) ReportGetByID(id string) (res *Report, err error) {
 // ReportsCreate Wrapper for report.create
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/report/create
 func (api *API) ReportsCreate(reports Reports) (err error) {
-	response, err := api.CallWithError("report.create", reports)
+	return api.ReportsCreateContext(context.Background(), reports)
+}
+
+// ReportsCreateContext is like ReportsCreate but uses ctx for the underlying API calls.
+func (api *API) ReportsCreateContext(ctx context.Context, reports Reports) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "report.create", reports)
 	if err != nil {
 		return
 	}
@@ -168,7 +185,12 @@ func (api *API) ReportsCreate(reports Reports) (err error) {
 // ReportsUpdate Wrapper for report.update
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/report/update
 func (api *API) ReportsUpdate(reports Reports) (err error) {
-	_, err = api.CallWithError("report.update", reports)
+	return api.ReportsUpdateContext(context.Background(), reports)
+}
+
+// ReportsUpdateContext is like ReportsUpdate but uses ctx for the underlying API calls.
+func (api *API) ReportsUpdateContext(ctx context.Context, reports Reports) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "report.update", reports)
 	return
 }
 
@@ -176,12 +198,17 @@ func (api *API) ReportsUpdate(reports Reports) (err error) {
 // Cleans ReportID in all reports elements if call succeeds.
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/report/delete
 func (api *API) ReportsDelete(reports Reports) (err error) {
+	return api.ReportsDeleteContext(context.Background(), reports)
+}
+
+// ReportsDeleteContext is like ReportsDelete but uses ctx for the underlying API calls.
+func (api *API) ReportsDeleteContext(ctx context.Context, reports Reports) (err error) {
 	ids := make([]string, len(reports))
 	for i, report := range reports {
 		ids[i] = report.ReportID
 	}
 
-	err = api.ReportsDeleteByIds(ids)
+	err = api.ReportsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range reports {
 			reports[i].ReportID = ""
@@ -193,7 +220,12 @@ func (api *API) ReportsDelete(reports Reports) (err error) {
 // ReportsDeleteByIds Wrapper for report.delete
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/report/delete
 func (api *API) ReportsDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("report.delete", ids)
+	return api.ReportsDeleteByIdsContext(context.Background(), ids)
+}
+
+// ReportsDeleteByIdsContext is like ReportsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) ReportsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "report.delete", ids)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,143 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// Defaults used by RetryPolicy when the corresponding field is left unset.
+const (
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+	DefaultRetryMaxBackoff     = 10 * time.Second
+	DefaultRetryMultiplier     = 2.0
+)
+
+// DefaultRetryableStatuses HTTP statuses retried when RetryPolicy.RetryableStatuses is nil.
+// These are typically returned by a reverse proxy while PHP-FPM or the frontend restarts.
+var DefaultRetryableStatuses = []int{429, 502, 503, 504}
+
+// RetryPolicy controls how failed requests are retried.
+// The zero value disables retries.
+//
+// Transport errors, HTTP statuses listed in RetryableStatuses and JSON-RPC
+// errors with a code listed in RetryableErrorCodes are retried with
+// exponential backoff. Only idempotent methods (*.get and apiinfo.version)
+// are retried unless RetryMutating is set.
+type RetryPolicy struct {
+	// MaxAttempts total number of attempts including the first one; values <= 1 disable retries
+	MaxAttempts int
+	// InitialBackoff delay before the first retry; 0 uses DefaultRetryInitialBackoff
+	InitialBackoff time.Duration
+	// MaxBackoff upper bound of a single delay; 0 uses DefaultRetryMaxBackoff
+	MaxBackoff time.Duration
+	// Multiplier growth factor of the delay between attempts; values <= 1 use DefaultRetryMultiplier
+	Multiplier float64
+	// Jitter fraction (0 to 1) of each delay that is randomized
+	Jitter float64
+	// RetryableStatuses HTTP statuses to retry; nil uses DefaultRetryableStatuses
+	RetryableStatuses []int
+	// RetryableErrorCodes JSON-RPC error codes to retry
+	RetryableErrorCodes []int
+	// RetryMutating also retries methods that modify data (create, update, delete, ...)
+	RetryMutating bool
+}
+
+// DefaultRetryPolicy returns a policy doing up to 3 attempts of idempotent methods
+// on transport errors, gateway errors and JSON-RPC internal errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:         3,
+		InitialBackoff:      DefaultRetryInitialBackoff,
+		MaxBackoff:          DefaultRetryMaxBackoff,
+		Multiplier:          DefaultRetryMultiplier,
+		Jitter:              0.2,
+		RetryableErrorCodes: []int{CodeInternalError},
+	}
+}
+
+// isIdempotentMethod reports whether method only reads data.
+func isIdempotentMethod(method string) bool {
+	m := strings.ToLower(method)
+	return strings.HasSuffix(m, ".get") || m == "apiinfo.version"
+}
+
+func (p *RetryPolicy) enabled(method string) bool {
+	if p.MaxAttempts <= 1 {
+		return false
+	}
+	return p.RetryMutating || isIdempotentMethod(method)
+}
+
+func (p *RetryPolicy) retryableStatus(status int) bool {
+	statuses := p.RetryableStatuses
+	if statuses == nil {
+		statuses = DefaultRetryableStatuses
+	}
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// retryableBody reports whether b is a JSON-RPC error with a retryable code.
+func (p *RetryPolicy) retryableBody(b []byte) bool {
+	if len(p.RetryableErrorCodes) == 0 {
+		return false
+	}
+	var res struct {
+		Error *Error `json:"error"`
+	}
+	if json.Unmarshal(b, &res) != nil || res.Error == nil {
+		return false
+	}
+	for _, c := range p.RetryableErrorCodes {
+		if c == res.Error.Code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay to wait after the given failed attempt (starting at 1).
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = DefaultRetryInitialBackoff
+	}
+	max := p.MaxBackoff
+	if max <= 0 {
+		max = DefaultRetryMaxBackoff
+	}
+	mult := p.Multiplier
+	if mult <= 1 {
+		mult = DefaultRetryMultiplier
+	}
+
+	d := float64(initial) * math.Pow(mult, float64(attempt-1))
+	if d > float64(max) {
+		d = float64(max)
+	}
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		d -= d * jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
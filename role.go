@@ -0,0 +1,294 @@
+package zabbix
+
+import (
+	"context"
+)
+
+type (
+	// RoleType user type of a role
+	// see "type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object
+	RoleType int
+
+	// RoleRuleStatusType whether a rule grants access
+	// see "status" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object#ui-element
+	RoleRuleStatusType int
+
+	// RoleServicesMode access to services
+	// see "services.read.mode" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object#role-rules
+	RoleServicesMode int
+
+	// RoleAPIMode how the API method list is applied
+	// see "api.mode" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object#role-rules
+	RoleAPIMode int
+)
+
+const (
+	// RoleTypeUser user
+	RoleTypeUser RoleType = 1
+	// RoleTypeAdmin admin
+	RoleTypeAdmin RoleType = 2
+	// RoleTypeSuperAdmin super admin
+	RoleTypeSuperAdmin RoleType = 3
+)
+
+const (
+	// RoleRuleDisabled access is denied
+	RoleRuleDisabled RoleRuleStatusType = 0
+	// RoleRuleEnabled access is granted
+	RoleRuleEnabled RoleRuleStatusType = 1
+)
+
+const (
+	// RoleServicesCustom access to the services in the list and matching the tag
+	RoleServicesCustom RoleServicesMode = 0
+	// RoleServicesAll access to all services
+	RoleServicesAll RoleServicesMode = 1
+)
+
+const (
+	// RoleAPIDenyList the listed API methods are denied
+	RoleAPIDenyList RoleAPIMode = 0
+	// RoleAPIAllowList only the listed API methods are allowed
+	RoleAPIAllowList RoleAPIMode = 1
+)
+
+// RoleRule grants or denies access to a UI element or an action
+// Name is for example "monitoring.hosts" or "edit_maintenance".
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object#ui-element
+type RoleRule struct {
+	Name   string             `json:"name"`
+	Status RoleRuleStatusType `json:"status,string"`
+}
+
+// RoleRules is an array of RoleRule
+type RoleRules []RoleRule
+
+// RoleModuleRule grants or denies access to a frontend module
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object#module
+type RoleModuleRule struct {
+	ModuleID string             `json:"moduleid"`
+	Status   RoleRuleStatusType `json:"status,string"`
+}
+
+// RoleModuleRules is an array of RoleModuleRule
+type RoleModuleRules []RoleModuleRule
+
+// RoleServiceID represent a service in a role service list
+type RoleServiceID struct {
+	ServiceID string `json:"serviceid"`
+}
+
+// RoleServiceIDs is an array of RoleServiceID
+type RoleServiceIDs []RoleServiceID
+
+// RoleServiceTag grants access to services with the given tag and, if set, value
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object#service-tag
+type RoleServiceTag struct {
+	Tag   string `json:"tag"`
+	Value string `json:"value,omitempty"`
+}
+
+// RolePermissions represent the rules of a role
+// The zero value denies everything, start from DefaultRolePermissions to only restrict some parts.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object#role-rules
+type RolePermissions struct {
+	UI              RoleRules          `json:"ui,omitempty"`
+	UIDefaultAccess RoleRuleStatusType `json:"ui.default_access,string"`
+
+	ServicesReadMode  RoleServicesMode `json:"services.read.mode,string"`
+	ServicesReadList  RoleServiceIDs   `json:"services.read.list,omitempty"`
+	ServicesReadTag   *RoleServiceTag  `json:"services.read.tag,omitempty"`
+	ServicesWriteMode RoleServicesMode `json:"services.write.mode,string"`
+	ServicesWriteList RoleServiceIDs   `json:"services.write.list,omitempty"`
+	ServicesWriteTag  *RoleServiceTag  `json:"services.write.tag,omitempty"`
+
+	Modules              RoleModuleRules    `json:"modules,omitempty"`
+	ModulesDefaultAccess RoleRuleStatusType `json:"modules.default_access,string"`
+
+	APIAccess RoleRuleStatusType `json:"api.access,string"`
+	APIMode   RoleAPIMode        `json:"api.mode,string"`
+	// API methods like "host.get" or "host.*"
+	API []string `json:"api,omitempty"`
+
+	Actions              RoleRules          `json:"actions,omitempty"`
+	ActionsDefaultAccess RoleRuleStatusType `json:"actions.default_access,string"`
+}
+
+// DefaultRolePermissions returns the rules Zabbix applies to a new role:
+// access to all UI elements, modules, actions and API methods, and read access to all services.
+func DefaultRolePermissions() *RolePermissions {
+	return &RolePermissions{
+		UIDefaultAccess:      RoleRuleEnabled,
+		ServicesReadMode:     RoleServicesAll,
+		ServicesWriteMode:    RoleServicesCustom,
+		ModulesDefaultAccess: RoleRuleEnabled,
+		APIAccess:            RoleRuleEnabled,
+		APIMode:              RoleAPIDenyList,
+		ActionsDefaultAccess: RoleRuleEnabled,
+	}
+}
+
+// Role represent Zabbix user role object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/object
+type Role struct {
+	RoleID string   `json:"roleid,omitempty"`
+	Name   string   `json:"name"`
+	Type   RoleType `json:"type,string"`
+	// Rules is only returned when requested with "selectRules", nil leaves the rules unchanged
+	Rules *RolePermissions `json:"rules,omitempty"`
+}
+
+// Roles is an array of Role
+type Roles []Role
+
+// RolesGet Wrapper for role.get
+// Selects the role rules unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/get
+func (api *API) RolesGet(params Params) (res Roles, err error) {
+	return api.RolesGetContext(context.Background(), params)
+}
+
+// RolesGetContext is like RolesGet but uses ctx for the underlying API calls.
+func (api *API) RolesGetContext(ctx context.Context, params Params) (res Roles, err error) {
+	for _, key := range []string{"output", "selectRules"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "role.get", params, &res)
+	return
+}
+
+// RoleGetByID Gets role by ID only if there is exactly 1 matching role.
+func (api *API) RoleGetByID(id string) (res *Role, err error) {
+	return api.RoleGetByIDContext(context.Background(), id)
+}
+
+// RoleGetByIDContext is like RoleGetByID but uses ctx for the underlying API calls.
+func (api *API) RoleGetByIDContext(ctx context.Context, id string) (res *Role, err error) {
+	roles, err := api.RolesGetContext(ctx, Params{"roleids": id})
+	if err != nil {
+		return
+	}
+
+	if len(roles) == 1 {
+		res = &roles[0]
+	} else {
+		e := ExpectedOneResult(len(roles))
+		err = &e
+	}
+	return
+}
+
+// RoleGetByName Gets role by name only if there is exactly 1 matching role.
+func (api *API) RoleGetByName(name string) (res *Role, err error) {
+	return api.RoleGetByNameContext(context.Background(), name)
+}
+
+// RoleGetByNameContext is like RoleGetByName but uses ctx for the underlying API calls.
+func (api *API) RoleGetByNameContext(ctx context.Context, name string) (res *Role, err error) {
+	roles, err := api.RolesGetContext(ctx, Params{"filter": map[string]string{"name": name}})
+	if err != nil {
+		return
+	}
+
+	if len(roles) == 1 {
+		res = &roles[0]
+	} else {
+		e := ExpectedOneResult(len(roles))
+		err = &e
+	}
+	return
+}
+
+// RoleIDByName Gets the ID of the role with the given name, as used in User.RoleID.
+func (api *API) RoleIDByName(name string) (id string, err error) {
+	return api.RoleIDByNameContext(context.Background(), name)
+}
+
+// RoleIDByNameContext is like RoleIDByName but uses ctx for the underlying API calls.
+func (api *API) RoleIDByNameContext(ctx context.Context, name string) (id string, err error) {
+	role, err := api.RoleGetByNameContext(ctx, name)
+	if err != nil {
+		return
+	}
+	id = role.RoleID
+	return
+}
+
+// RolesCreate Wrapper for role.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/create
+func (api *API) RolesCreate(roles Roles) (err error) {
+	return api.RolesCreateContext(context.Background(), roles)
+}
+
+// RolesCreateContext is like RolesCreate but uses ctx for the underlying API calls.
+func (api *API) RolesCreateContext(ctx context.Context, roles Roles) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "role.create", roles)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	roleids := result["roleids"].([]interface{})
+	for i, id := range roleids {
+		roles[i].RoleID = id.(string)
+	}
+	return
+}
+
+// RolesUpdate Wrapper for role.update
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/update
+func (api *API) RolesUpdate(roles Roles) (err error) {
+	return api.RolesUpdateContext(context.Background(), roles)
+}
+
+// RolesUpdateContext is like RolesUpdate but uses ctx for the underlying API calls.
+func (api *API) RolesUpdateContext(ctx context.Context, roles Roles) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "role.update", roles)
+	return
+}
+
+// RolesDelete Wrapper for role.delete
+// Cleans RoleID in all roles elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/delete
+func (api *API) RolesDelete(roles Roles) (err error) {
+	return api.RolesDeleteContext(context.Background(), roles)
+}
+
+// RolesDeleteContext is like RolesDelete but uses ctx for the underlying API calls.
+func (api *API) RolesDeleteContext(ctx context.Context, roles Roles) (err error) {
+	ids := make([]string, len(roles))
+	for i, role := range roles {
+		ids[i] = role.RoleID
+	}
+
+	err = api.RolesDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range roles {
+			roles[i].RoleID = ""
+		}
+	}
+	return
+}
+
+// RolesDeleteByIds Wrapper for role.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/role/delete
+func (api *API) RolesDeleteByIds(ids []string) (err error) {
+	return api.RolesDeleteByIdsContext(context.Background(), ids)
+}
+
+// RolesDeleteByIdsContext is like RolesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) RolesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "role.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	roleids := result["roleids"].([]interface{})
+	if len(ids) != len(roleids) {
+		err = &ExpectedMore{len(ids), len(roleids)}
+	}
+	return
+}
@@ -0,0 +1,89 @@
+package zabbix_test
+
+import (
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestRolesGet(t *testing.T) {
+	api := getAPI(t)
+
+	roles, err := api.RolesGet(zapi.Params{})
+	if err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+	for _, role := range roles {
+		if role.Rules == nil {
+			t.Errorf("rules not selected for role %q", role.Name)
+		}
+	}
+}
+
+func TestRolesFake(t *testing.T) {
+	api, _ := getFakeAPI(t)
+
+	rules := zapi.DefaultRolePermissions()
+	rules.UI = zapi.RoleRules{{Name: "configuration.hosts", Status: zapi.RoleRuleDisabled}}
+	rules.ServicesReadMode = zapi.RoleServicesCustom
+	rules.ServicesReadTag = &zapi.RoleServiceTag{Tag: "team", Value: "ops"}
+	rules.APIMode = zapi.RoleAPIAllowList
+	rules.API = []string{"host.get", "item.*"}
+	rules.Actions = zapi.RoleRules{{Name: "edit_maintenance", Status: zapi.RoleRuleDisabled}}
+
+	roles := zapi.Roles{{Name: "Ops", Type: zapi.RoleTypeAdmin, Rules: rules}}
+	if err := api.RolesCreate(roles); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := api.RoleGetByName("Ops")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != zapi.RoleTypeAdmin || got.Rules == nil {
+		t.Fatalf("unexpected role %#v", got)
+	}
+	r := got.Rules
+	if r.UIDefaultAccess != zapi.RoleRuleEnabled || len(r.UI) != 1 || r.UI[0].Status != zapi.RoleRuleDisabled {
+		t.Errorf("unexpected UI rules %#v", r)
+	}
+	if r.ServicesReadMode != zapi.RoleServicesCustom || r.ServicesReadTag == nil || r.ServicesReadTag.Value != "ops" {
+		t.Errorf("unexpected service rules %#v", r)
+	}
+	if r.APIMode != zapi.RoleAPIAllowList || len(r.API) != 2 || r.API[1] != "item.*" {
+		t.Errorf("unexpected API rules %#v", r)
+	}
+
+	id, err := api.RoleIDByName("Ops")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != roles[0].RoleID {
+		t.Errorf("expected role ID %s, got %s", roles[0].RoleID, id)
+	}
+	if _, err = api.RoleIDByName("missing"); err == nil {
+		t.Error("expected error for missing role")
+	}
+
+	users := zapi.Users{{Username: "ops-bot", Password: "Secret-123", RoleID: id}}
+	if err = api.UsersCreate(users); err != nil {
+		t.Fatal(err)
+	}
+
+	got.Type = zapi.RoleTypeUser
+	got.Rules = nil
+	if err = api.RolesUpdate(zapi.Roles{*got}); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = api.RoleGetByID(id); err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != zapi.RoleTypeUser || got.Rules == nil || got.Rules.APIMode != zapi.RoleAPIAllowList {
+		t.Errorf("unexpected role after update %#v", got)
+	}
+
+	if err = api.RolesDelete(roles); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,115 @@
+package sender
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Protocol header flags
+// https://www.zabbix.com/documentation/7.0/en/manual/appendix/protocols/header_datalen
+const (
+	// FlagProtocol Zabbix protocol
+	FlagProtocol byte = 0x01
+	// FlagCompressed data is zlib compressed
+	FlagCompressed byte = 0x02
+	// FlagLargePacket data length fields are 8 bytes long
+	FlagLargePacket byte = 0x04
+)
+
+// MaxPacketSize largest packet accepted by ReadPacket.
+const MaxPacketSize = 128 << 20
+
+var headerMagic = []byte("ZBXD")
+
+// ErrBadHeader returned when a packet does not start with the ZBXD header.
+var ErrBadHeader = errors.New("zabbix sender: invalid protocol header")
+
+// WritePacket writes data to w prefixed with the ZBXD header.
+// When compress is true data is zlib compressed and the header
+// carries the uncompressed length in its reserved field.
+func WritePacket(w io.Writer, data []byte, compress bool) error {
+	flags := FlagProtocol
+	payload := data
+	var reserved uint32
+	if compress {
+		var buf bytes.Buffer
+		zw := zlib.NewWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		flags |= FlagCompressed
+		payload = buf.Bytes()
+		reserved = uint32(len(data))
+	}
+
+	header := make([]byte, 13, 13+len(payload))
+	copy(header, headerMagic)
+	header[4] = flags
+	binary.LittleEndian.PutUint32(header[5:9], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(header[9:13], reserved)
+
+	_, err := w.Write(append(header, payload...))
+	return err
+}
+
+// ReadPacket reads one ZBXD packet from r and returns its uncompressed data.
+func ReadPacket(r io.Reader) ([]byte, error) {
+	prefix := make([]byte, 5)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(prefix[:4], headerMagic) || prefix[4]&FlagProtocol == 0 {
+		return nil, ErrBadHeader
+	}
+	flags := prefix[4]
+
+	var size, reserved uint64
+	if flags&FlagLargePacket != 0 {
+		lengths := make([]byte, 16)
+		if _, err := io.ReadFull(r, lengths); err != nil {
+			return nil, err
+		}
+		size = binary.LittleEndian.Uint64(lengths[:8])
+		reserved = binary.LittleEndian.Uint64(lengths[8:])
+	} else {
+		lengths := make([]byte, 8)
+		if _, err := io.ReadFull(r, lengths); err != nil {
+			return nil, err
+		}
+		size = uint64(binary.LittleEndian.Uint32(lengths[:4]))
+		reserved = uint64(binary.LittleEndian.Uint32(lengths[4:]))
+	}
+	if size > MaxPacketSize || reserved > MaxPacketSize {
+		return nil, fmt.Errorf("zabbix sender: packet of %d bytes exceeds limit", size)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	if flags&FlagCompressed == 0 {
+		return data, nil
+	}
+
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("zabbix sender: decompress packet: %w", err)
+	}
+	defer zr.Close()
+	out, err := ioutil.ReadAll(io.LimitReader(zr, int64(reserved)+1))
+	if err != nil {
+		return nil, fmt.Errorf("zabbix sender: decompress packet: %w", err)
+	}
+	if uint64(len(out)) != reserved {
+		return nil, fmt.Errorf("zabbix sender: decompressed %d bytes, header announced %d", len(out), reserved)
+	}
+	return out, nil
+}
@@ -0,0 +1,271 @@
+/*
+Package sender implements the Zabbix sender (trapper) protocol, pushing values
+into items of type ZabbixTrapper without shelling out to zabbix_sender.
+
+	s := sender.New(sender.Config{Addr: "zabbix.example.com:10051"})
+	res, err := s.Send(ctx, []sender.Value{
+		sender.NewValue("web01", "app.requests", "42", time.Now()),
+	})
+
+https://www.zabbix.com/documentation/7.0/en/manual/appendix/protocols/zabbix_sender
+*/
+package sender
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// DefaultPort trapper port used when Config.Addr has no port.
+const DefaultPort = "10051"
+
+// DefaultTimeout connection timeout used when Config.Timeout is unset.
+const DefaultTimeout = 30 * time.Second
+
+// DefaultBatchSize maximum number of values per request, same as zabbix_sender.
+const DefaultBatchSize = 250
+
+// Value a single value for a trapper item.
+type Value struct {
+	Host  string `json:"host"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	// Clock and NS timestamp of the value; omitted when Clock is 0 so the server uses the receive time
+	Clock int64 `json:"clock,omitempty"`
+	NS    int   `json:"ns,omitempty"`
+}
+
+// NewValue builds a Value timestamped with t.
+func NewValue(host, key, value string, t time.Time) Value {
+	return Value{
+		Host:  host,
+		Key:   key,
+		Value: value,
+		Clock: t.Unix(),
+		NS:    t.Nanosecond(),
+	}
+}
+
+// PSKDialFunc upgrades an established TCP connection to TLS-PSK.
+// Go's crypto/tls does not implement PSK cipher suites, so PSK support
+// is provided by plugging in an external TLS implementation here.
+type PSKDialFunc func(ctx context.Context, conn net.Conn, identity string, key []byte) (net.Conn, error)
+
+// PSK pre-shared key settings for encrypted connections.
+type PSK struct {
+	Identity string
+	Key      []byte
+	Dial     PSKDialFunc
+}
+
+// Config sender configuration
+type Config struct {
+	// Addr host:port of the Zabbix server or proxy; DefaultPort is used when the port is omitted
+	Addr string
+	// Timeout for connecting and exchanging one batch; 0 uses DefaultTimeout
+	Timeout time.Duration
+	// BatchSize maximum number of values per request; 0 uses DefaultBatchSize
+	BatchSize int
+	// Compress zlib compresses requests
+	Compress bool
+	// TLS enables certificate based encryption
+	TLS *tls.Config
+	// PSK enables pre-shared key encryption; mutually exclusive with TLS
+	PSK *PSK
+}
+
+// Result of a Send call, summed over all batches
+type Result struct {
+	Processed int
+	Failed    int
+	Total     int
+	Seconds   float64
+}
+
+// ResponseError returned when the server does not answer with "success".
+type ResponseError struct {
+	Response string
+	Info     string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("zabbix sender: server responded %q: %s", e.Response, e.Info)
+}
+
+type request struct {
+	Request string  `json:"request"`
+	Data    []Value `json:"data"`
+	Clock   int64   `json:"clock"`
+	NS      int     `json:"ns"`
+}
+
+type response struct {
+	Response string `json:"response"`
+	Info     string `json:"info"`
+}
+
+var infoPattern = regexp.MustCompile(`processed: (\d+); failed: (\d+); total: (\d+); seconds spent: ([\d.]+)`)
+
+// parseInfo parses the "info" field of a sender response.
+func parseInfo(info string) (res Result, err error) {
+	m := infoPattern.FindStringSubmatch(info)
+	if m == nil {
+		err = fmt.Errorf("zabbix sender: unexpected response info %q", info)
+		return
+	}
+	res.Processed, _ = strconv.Atoi(m[1])
+	res.Failed, _ = strconv.Atoi(m[2])
+	res.Total, _ = strconv.Atoi(m[3])
+	res.Seconds, _ = strconv.ParseFloat(m[4], 64)
+	return
+}
+
+// Sender sends values to a Zabbix server or proxy.
+// It is safe for concurrent use; every batch uses its own connection.
+type Sender struct {
+	cfg Config
+}
+
+// New creates a Sender.
+func New(c Config) *Sender {
+	return &Sender{cfg: c}
+}
+
+// Send sends values in batches of Config.BatchSize and returns the summed result.
+// On error the result contains the batches sent successfully so far.
+func (s *Sender) Send(ctx context.Context, values []Value) (res Result, err error) {
+	size := s.cfg.BatchSize
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+	for start := 0; start < len(values); start += size {
+		end := start + size
+		if end > len(values) {
+			end = len(values)
+		}
+		var r Result
+		r, err = s.sendBatch(ctx, values[start:end])
+		if err != nil {
+			return
+		}
+		res.Processed += r.Processed
+		res.Failed += r.Failed
+		res.Total += r.Total
+		res.Seconds += r.Seconds
+	}
+	return
+}
+
+func (s *Sender) addr() string {
+	if _, _, err := net.SplitHostPort(s.cfg.Addr); err != nil {
+		return net.JoinHostPort(s.cfg.Addr, DefaultPort)
+	}
+	return s.cfg.Addr
+}
+
+func (s *Sender) dial(ctx context.Context) (conn net.Conn, err error) {
+	if s.cfg.TLS != nil && s.cfg.PSK != nil {
+		return nil, errors.New("zabbix sender: TLS and PSK are mutually exclusive")
+	}
+
+	var d net.Dialer
+	conn, err = d.DialContext(ctx, "tcp", s.addr())
+	if err != nil {
+		return
+	}
+
+	switch {
+	case s.cfg.TLS != nil:
+		tc := tls.Client(conn, s.cfg.TLS)
+		if err = tc.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tc
+	case s.cfg.PSK != nil:
+		if s.cfg.PSK.Dial == nil {
+			conn.Close()
+			return nil, errors.New("zabbix sender: PSK requires a PSKDialFunc")
+		}
+		var pc net.Conn
+		pc, err = s.cfg.PSK.Dial(ctx, conn, s.cfg.PSK.Identity, s.cfg.PSK.Key)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = pc
+	}
+	return
+}
+
+func (s *Sender) sendBatch(ctx context.Context, values []Value) (res Result, err error) {
+	timeout := s.cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	now := time.Now()
+	data, err := json.Marshal(request{
+		Request: "sender data",
+		Data:    values,
+		Clock:   now.Unix(),
+		NS:      now.Nanosecond(),
+	})
+	if err != nil {
+		return
+	}
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	// abort blocking reads and writes when ctx is done
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.SetDeadline(time.Unix(1, 0))
+		case <-stop:
+		}
+	}()
+
+	if err = WritePacket(conn, data, s.cfg.Compress); err != nil {
+		return res, ctxErr(ctx, err)
+	}
+	b, err := ReadPacket(conn)
+	if err != nil {
+		return res, ctxErr(ctx, err)
+	}
+
+	var resp response
+	if err = json.Unmarshal(b, &resp); err != nil {
+		return
+	}
+	if resp.Response != "success" {
+		err = &ResponseError{Response: resp.Response, Info: resp.Info}
+		return
+	}
+	return parseInfo(resp.Info)
+}
+
+// ctxErr prefers the context error over the I/O error it caused.
+func ctxErr(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
@@ -0,0 +1,239 @@
+package sender
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeTrapper is an in-process trapper listener recording received requests.
+type fakeTrapper struct {
+	ln       net.Listener
+	mu       sync.Mutex
+	requests []request
+}
+
+func newFakeTrapper(t *testing.T, tlsConfig *tls.Config) *fakeTrapper {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tlsConfig != nil {
+		ln = tls.NewListener(ln, tlsConfig)
+	}
+	f := &fakeTrapper{ln: ln}
+	t.Cleanup(func() { ln.Close() })
+	go f.serve()
+	return f
+}
+
+func (f *fakeTrapper) serve() {
+	for {
+		conn, err := f.ln.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeTrapper) handle(conn net.Conn) {
+	defer conn.Close()
+	data, err := ReadPacket(conn)
+	if err != nil {
+		return
+	}
+	var req request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return
+	}
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	f.mu.Unlock()
+
+	failed := 0
+	for _, v := range req.Data {
+		if v.Key == "" {
+			failed++
+		}
+	}
+	resp, _ := json.Marshal(response{
+		Response: "success",
+		Info: fmt.Sprintf("processed: %d; failed: %d; total: %d; seconds spent: 0.000100",
+			len(req.Data)-failed, failed, len(req.Data)),
+	})
+	WritePacket(conn, resp, false)
+}
+
+func (f *fakeTrapper) addr() string {
+	return f.ln.Addr().String()
+}
+
+func TestPacketRoundTrip(t *testing.T) {
+	payload := []byte(`{"request":"sender data","data":[]}`)
+	for _, compress := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := WritePacket(&buf, payload, compress); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(buf.Bytes(), []byte("ZBXD")) {
+			t.Fatalf("missing header: %q", buf.Bytes())
+		}
+		if compress && buf.Bytes()[4]&FlagCompressed == 0 {
+			t.Fatal("compressed flag not set")
+		}
+		got, err := ReadPacket(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, payload) {
+			t.Errorf("compress=%v: expected %s, got %s", compress, payload, got)
+		}
+	}
+
+	if _, err := ReadPacket(bytes.NewReader([]byte("HTTP/1.1 400"))); err != ErrBadHeader {
+		t.Errorf("expected ErrBadHeader, got %v", err)
+	}
+}
+
+func TestParseInfo(t *testing.T) {
+	res, err := parseInfo("processed: 3; failed: 1; total: 4; seconds spent: 0.000055")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Result{Processed: 3, Failed: 1, Total: 4, Seconds: 0.000055}
+	if res != expected {
+		t.Errorf("expected %+v, got %+v", expected, res)
+	}
+	if _, err := parseInfo("garbage"); err == nil {
+		t.Error("expected error for malformed info")
+	}
+}
+
+func TestSendBatches(t *testing.T) {
+	f := newFakeTrapper(t, nil)
+	s := New(Config{Addr: f.addr(), BatchSize: 2, Compress: true})
+
+	now := time.Now()
+	values := []Value{
+		NewValue("host1", "key1", "1", now),
+		NewValue("host1", "key2", "2", now),
+		NewValue("host2", "key1", "3", now),
+		NewValue("host2", "", "4", now),
+		{Host: "host3", Key: "key1", Value: "5"},
+	}
+	res, err := s.Send(context.Background(), values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Processed != 4 || res.Failed != 1 || res.Total != 5 {
+		t.Errorf("unexpected result %+v", res)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.requests) != 3 {
+		t.Fatalf("expected 3 batches, got %d", len(f.requests))
+	}
+	for _, req := range f.requests {
+		if req.Request != "sender data" {
+			t.Errorf("unexpected request type %q", req.Request)
+		}
+	}
+	if got := f.requests[0].Data[0]; got.Clock != now.Unix() || got.NS != now.Nanosecond() {
+		t.Errorf("timestamp not sent: %+v", got)
+	}
+}
+
+func TestSendTLS(t *testing.T) {
+	cert, pool := selfSignedCert(t)
+	f := newFakeTrapper(t, &tls.Config{Certificates: []tls.Certificate{cert}})
+	s := New(Config{
+		Addr: f.addr(),
+		TLS:  &tls.Config{RootCAs: pool, ServerName: "127.0.0.1"},
+	})
+
+	res, err := s.Send(context.Background(), []Value{{Host: "host1", Key: "key1", Value: "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Processed != 1 {
+		t.Errorf("unexpected result %+v", res)
+	}
+}
+
+func TestSendPSKRequiresDialer(t *testing.T) {
+	f := newFakeTrapper(t, nil)
+	s := New(Config{Addr: f.addr(), PSK: &PSK{Identity: "id", Key: []byte{1}}})
+	if _, err := s.Send(context.Background(), []Value{{Host: "h", Key: "k", Value: "v"}}); err == nil {
+		t.Fatal("expected error without PSKDialFunc")
+	}
+}
+
+func TestSendContextCancel(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	// accept but never answer
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			defer conn.Close()
+			time.Sleep(5 * time.Second)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	s := New(Config{Addr: ln.Addr().String()})
+	_, err = s.Send(ctx, []Value{{Host: "h", Key: "k", Value: "v"}})
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func selfSignedCert(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "zabbix-test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(parsed)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 type (
 	// ServiceAlgorithmType status calculation rule for the service
 	// see "algorithm" in: https://www.zabbix.com/documentation/6.0/en/manual/api/reference/service/object
@@ -123,16 +125,26 @@ type Services []Service
 // ServicesGet Wrapper for service.get
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/service/get
 func (api *API) ServicesGet(params Params) (res Services, err error) {
+	return api.ServicesGetContext(context.Background(), params)
+}
+
+// ServicesGetContext is like ServicesGet but uses ctx for the underlying API calls.
+func (api *API) ServicesGetContext(ctx context.Context, params Params) (res Services, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("service.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "service.get", params, &res)
 	return
 }
 
 // ServiceGetByID Gets service by ID only if there is exactly 1 matching service.
 func (api *API) ServiceGetByID(id string) (res *Service, err error) {
-	services, err := api.ServicesGet(Params{"serviceids": id})
+	return api.ServiceGetByIDContext(context.Background(), id)
+}
+
+// ServiceGetByIDContext is like ServiceGetByID but uses ctx for the underlying API calls.
+func (api *API) ServiceGetByIDContext(ctx context.Context, id string) (res *Service, err error) {
+	services, err := api.ServicesGetContext(ctx, Params{"serviceids": id})
 	if err != nil {
 		return
 	}
@@ -149,7 +161,12 @@ func (api *API) ServiceGetByID(id string) (res *Service, err error) {
 // ServicesCreate Wrapper for service.create
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/service/create
 func (api *API) ServicesCreate(services Services) (err error) {
-	response, err := api.CallWithError("service.create", services)
+	return api.ServicesCreateContext(context.Background(), services)
+}
+
+// ServicesCreateContext is like ServicesCreate but uses ctx for the underlying API calls.
+func (api *API) ServicesCreateContext(ctx context.Context, services Services) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "service.create", services)
 	if err != nil {
 		return
 	}
@@ -165,7 +182,12 @@ func (api *API) ServicesCreate(services Services) (err error) {
 // ServicesUpdate Wrapper for service.update
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/service/update
 func (api *API) ServicesUpdate(services Services) (err error) {
-	_, err = api.CallWithError("service.update", services)
+	return api.ServicesUpdateContext(context.Background(), services)
+}
+
+// ServicesUpdateContext is like ServicesUpdate but uses ctx for the underlying API calls.
+func (api *API) ServicesUpdateContext(ctx context.Context, services Services) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "service.update", services)
 	return
 }
 
@@ -173,12 +195,17 @@ func (api *API) ServicesUpdate(services Services) (err error) {
 // Cleans ServiceID in all services elements if call succeeds.
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/service/delete
 func (api *API) ServicesDelete(services Services) (err error) {
+	return api.ServicesDeleteContext(context.Background(), services)
+}
+
+// ServicesDeleteContext is like ServicesDelete but uses ctx for the underlying API calls.
+func (api *API) ServicesDeleteContext(ctx context.Context, services Services) (err error) {
 	ids := make([]string, len(services))
 	for i, service := range services {
 		ids[i] = service.ServiceID
 	}
 
-	err = api.ServicesDeleteByIds(ids)
+	err = api.ServicesDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range services {
 			services[i].ServiceID = ""
@@ -190,7 +217,12 @@ func (api *API) ServicesDelete(services Services) (err error) {
 // ServicesDeleteByIds Wrapper for service.delete
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/service/delete
 func (api *API) ServicesDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("service.delete", ids)
+	return api.ServicesDeleteByIdsContext(context.Background(), ids)
+}
+
+// ServicesDeleteByIdsContext is like ServicesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) ServicesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "service.delete", ids)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,173 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// Settings represent Zabbix global settings object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/settings/object
+type Settings struct {
+	// GUI
+	DefaultLang          string `json:"default_lang"`
+	DefaultTimezone      string `json:"default_timezone"`
+	DefaultTheme         string `json:"default_theme"`
+	SearchLimit          int    `json:"search_limit,string"`
+	MaxOverviewTableSize int    `json:"max_overview_table_size,string"`
+	MaxInTable           int    `json:"max_in_table,string"`
+	ServerCheckInterval  int    `json:"server_check_interval,string"`
+	WorkPeriod           string `json:"work_period"`
+	ShowTechnicalErrors  int    `json:"show_technical_errors,string"`
+	HistoryPeriod        string `json:"history_period"`
+	PeriodDefault        string `json:"period_default"`
+	MaxPeriod            string `json:"max_period"`
+
+	// Trigger severities, indexed by SeverityType
+	SeverityColor0 string `json:"severity_color_0"`
+	SeverityColor1 string `json:"severity_color_1"`
+	SeverityColor2 string `json:"severity_color_2"`
+	SeverityColor3 string `json:"severity_color_3"`
+	SeverityColor4 string `json:"severity_color_4"`
+	SeverityColor5 string `json:"severity_color_5"`
+	SeverityName0  string `json:"severity_name_0"`
+	SeverityName1  string `json:"severity_name_1"`
+	SeverityName2  string `json:"severity_name_2"`
+	SeverityName3  string `json:"severity_name_3"`
+	SeverityName4  string `json:"severity_name_4"`
+	SeverityName5  string `json:"severity_name_5"`
+
+	// Trigger displaying options
+	CustomColor       int    `json:"custom_color,string"`
+	OKPeriod          string `json:"ok_period"`
+	BlinkPeriod       string `json:"blink_period"`
+	ProblemUnackColor string `json:"problem_unack_color"`
+	ProblemAckColor   string `json:"problem_ack_color"`
+	OKUnackColor      string `json:"ok_unack_color"`
+	OKAckColor        string `json:"ok_ack_color"`
+	ProblemUnackStyle int    `json:"problem_unack_style,string"`
+	ProblemAckStyle   int    `json:"problem_ack_style,string"`
+	OKUnackStyle      int    `json:"ok_unack_style,string"`
+	OKAckStyle        int    `json:"ok_ack_style,string"`
+
+	// Other
+	URL                        string        `json:"url"`
+	DiscoveryGroupID           string        `json:"discovery_groupid"`
+	DefaultInventoryMode       InventoryMode `json:"default_inventory_mode,string"`
+	AlertUserGroupID           string        `json:"alert_usrgrpid"`
+	SNMPTrapLogging            int           `json:"snmptrap_logging,string"`
+	LoginAttempts              int           `json:"login_attempts,string"`
+	LoginBlock                 string        `json:"login_block"`
+	ValidateURISchemes         int           `json:"validate_uri_schemes,string"`
+	URIValidSchemes            string        `json:"uri_valid_schemes"`
+	XFrameOptions              string        `json:"x_frame_options"`
+	IframeSandboxingEnabled    int           `json:"iframe_sandboxing_enabled,string"`
+	IframeSandboxingExceptions string        `json:"iframe_sandboxing_exceptions"`
+	AuditlogEnabled            int           `json:"auditlog_enabled,string"`
+	AuditlogMode               int           `json:"auditlog_mode,string"`
+	HAFailoverDelay            string        `json:"ha_failover_delay"`
+	VaultProvider              int           `json:"vault_provider,string"`
+
+	// Geographical maps
+	GeomapsTileProvider string `json:"geomaps_tile_provider"`
+	GeomapsTileURL      string `json:"geomaps_tile_url"`
+	GeomapsMaxZoom      int    `json:"geomaps_max_zoom,string"`
+	GeomapsAttribution  string `json:"geomaps_attribution"`
+
+	// Frontend timeouts
+	ConnectTimeout       string `json:"connect_timeout"`
+	SocketTimeout        string `json:"socket_timeout"`
+	MediaTypeTestTimeout string `json:"media_type_test_timeout"`
+	ScriptTimeout        string `json:"script_timeout"`
+	ItemTestTimeout      string `json:"item_test_timeout"`
+	ReportTestTimeout    string `json:"report_test_timeout"`
+
+	// Default item timeouts
+	TimeoutZabbixAgent   string `json:"timeout_zabbix_agent"`
+	TimeoutSimpleCheck   string `json:"timeout_simple_check"`
+	TimeoutSNMPAgent     string `json:"timeout_snmp_agent"`
+	TimeoutExternalCheck string `json:"timeout_external_check"`
+	TimeoutDBMonitor     string `json:"timeout_db_monitor"`
+	TimeoutHTTPAgent     string `json:"timeout_http_agent"`
+	TimeoutSSHAgent      string `json:"timeout_ssh_agent"`
+	TimeoutTelnetAgent   string `json:"timeout_telnet_agent"`
+	TimeoutScript        string `json:"timeout_script"`
+	TimeoutBrowser       string `json:"timeout_browser"`
+}
+
+// SettingsGet Wrapper for settings.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/settings/get
+func (api *API) SettingsGet() (res *Settings, err error) {
+	return api.SettingsGetContext(context.Background())
+}
+
+// SettingsGetContext is like SettingsGet but uses ctx for the underlying API calls.
+func (api *API) SettingsGetContext(ctx context.Context) (res *Settings, err error) {
+	res = &Settings{}
+	err = api.CallWithErrorParseContext(ctx, "settings.get", Params{"output": "extend"}, res)
+	if err != nil {
+		res = nil
+	}
+	return
+}
+
+// SettingsUpdate Wrapper for settings.update
+// Sends only the fields that differ from the current settings and returns their names,
+// so start from SettingsGet rather than the zero value.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/settings/update
+func (api *API) SettingsUpdate(settings *Settings) (changed []string, err error) {
+	return api.SettingsUpdateContext(context.Background(), settings)
+}
+
+// SettingsUpdateContext is like SettingsUpdate but uses ctx for the underlying API calls.
+func (api *API) SettingsUpdateContext(ctx context.Context, settings *Settings) (changed []string, err error) {
+	return api.updateChanged(ctx, "settings", settings, nil, nil)
+}
+
+// updateChanged reads the current settings object with object.get, sends the fields of
+// desired that differ from it to object.update and returns their sorted names.
+// Fields missing from the current object, like those of newer server versions, are skipped
+// unless listed in writeOnly, as get never returns them. Nothing is sent if no field changed.
+func (api *API) updateChanged(ctx context.Context, object string, desired interface{}, readOnly, writeOnly []string) (changed []string, err error) {
+	var current map[string]interface{}
+	err = api.CallWithErrorParseContext(ctx, object+".get", Params{"output": "extend"}, &current)
+	if err != nil {
+		return
+	}
+
+	b, err := json.Marshal(desired)
+	if err != nil {
+		return
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		return
+	}
+
+	update := Params{}
+	for name, value := range fields {
+		if containsString(readOnly, name) {
+			continue
+		}
+		v, ok := current[name]
+		if !ok && !containsString(writeOnly, name) {
+			continue
+		}
+		if ok && reflect.DeepEqual(v, value) {
+			continue
+		}
+		update[name] = value
+		changed = append(changed, name)
+	}
+	if len(update) == 0 {
+		return
+	}
+	sort.Strings(changed)
+
+	_, err = api.CallWithErrorContext(ctx, object+".update", update)
+	if err != nil {
+		changed = nil
+	}
+	return
+}
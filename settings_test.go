@@ -0,0 +1,152 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+	"github.com/kgeroczi/go-zabbix-api/zabbixtest"
+)
+
+func TestSettingsGet(t *testing.T) {
+	api := getAPI(t)
+
+	if _, err := api.SettingsGet(); err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+	if _, err := api.HousekeepingGet(); err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+	if _, err := api.AutoregistrationGet(); err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+}
+
+func TestSettingsFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	var sent []json.RawMessage
+	srv.Handle("settings.update", func(params json.RawMessage) (interface{}, error) {
+		sent = append(sent, params)
+		return []string{}, nil
+	})
+
+	settings, err := api.SettingsGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.DefaultTheme != "blue-theme" || settings.SearchLimit != 1000 || settings.DefaultInventoryMode != zapi.InventoryDisabled {
+		t.Errorf("unexpected settings %#v", settings)
+	}
+
+	changed, err := api.SettingsUpdate(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changed) != 0 || len(sent) != 0 {
+		t.Errorf("unchanged settings sent: %v", changed)
+	}
+
+	settings.DefaultTheme = "dark-theme"
+	settings.SearchLimit = 500
+	if changed, err = api.SettingsUpdate(settings); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(changed, []string{"default_theme", "search_limit"}) {
+		t.Errorf("unexpected changed fields %v", changed)
+	}
+	var fields map[string]string
+	if len(sent) != 1 || json.Unmarshal(sent[0], &fields) != nil || len(fields) != 2 || fields["search_limit"] != "500" {
+		t.Errorf("unexpected update request %s", sent)
+	}
+}
+
+func TestSettingsOlderServerFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	// a 6.0 server has no item timeout settings
+	old := map[string]interface{}{}
+	for k, v := range zabbixtest.DefaultSingletons["settings"] {
+		if !strings.HasPrefix(k, "timeout_") {
+			old[k] = v
+		}
+	}
+	srv.SetSingleton("settings", old)
+
+	var sent []json.RawMessage
+	srv.Handle("settings.update", func(params json.RawMessage) (interface{}, error) {
+		sent = append(sent, params)
+		return []string{}, nil
+	})
+
+	settings, err := api.SettingsGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings.DefaultTheme = "dark-theme"
+	settings.TimeoutZabbixAgent = "10s"
+	changed, err := api.SettingsUpdate(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(changed, []string{"default_theme"}) {
+		t.Errorf("unexpected changed fields %v", changed)
+	}
+	var fields map[string]string
+	if len(sent) != 1 || json.Unmarshal(sent[0], &fields) != nil || len(fields) != 1 {
+		t.Errorf("unexpected update request %s", sent)
+	}
+}
+
+func TestHousekeepingFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	hk, err := api.HousekeepingGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hk.HistoryGlobal = 1
+	hk.History = "90d"
+	hk.DBExtension = "timescaledb"
+	changed, err := api.HousekeepingUpdate(hk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(changed, []string{"hk_history", "hk_history_global"}) {
+		t.Errorf("unexpected changed fields %v", changed)
+	}
+	if stored := srv.Singleton("housekeeping"); stored["hk_history"] != "90d" || stored["db_extension"] != "" {
+		t.Errorf("unexpected stored housekeeping %v", stored)
+	}
+}
+
+func TestAutoregistrationFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	ar, err := api.AutoregistrationGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ar.TLSAccept != zapi.AutoregistrationUnencrypted || ar.TLSPSK != "" {
+		t.Errorf("unexpected autoregistration %#v", ar)
+	}
+
+	ar.TLSAccept = zapi.AutoregistrationUnencrypted | zapi.AutoregistrationPSK
+	ar.TLSPSKIdentity = "autoreg"
+	ar.TLSPSK = "ec30a947e6776ae9efb77f46aefcba04"
+	changed, err := api.AutoregistrationUpdate(ar)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(changed, []string{"tls_accept", "tls_psk", "tls_psk_identity"}) {
+		t.Errorf("unexpected changed fields %v", changed)
+	}
+	if stored := srv.Singleton("autoregistration"); stored["tls_accept"] != "3" || stored["tls_psk_identity"] != "autoreg" {
+		t.Errorf("unexpected stored autoregistration %v", stored)
+	}
+}
@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 type (
 	// SLAPeriodType reporting period of the SLA
 	// see "period" in: https://www.zabbix.com/documentation/6.0/en/manual/api/reference/sla/object
@@ -88,16 +90,26 @@ type SLAs []SLA
 // SLAsGet Wrapper for sla.get
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/sla/get
 func (api *API) SLAsGet(params Params) (res SLAs, err error) {
+	return api.SLAsGetContext(context.Background(), params)
+}
+
+// SLAsGetContext is like SLAsGet but uses ctx for the underlying API calls.
+func (api *API) SLAsGetContext(ctx context.Context, params Params) (res SLAs, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("sla.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "sla.get", params, &res)
 	return
 }
 
 // SLAGetByID Gets SLA by ID only if there is exactly 1 matching SLA.
 func (api *API) SLAGetByID(id string) (res *SLA, err error) {
-	slas, err := api.SLAsGet(Params{"slaids": id})
+	return api.SLAGetByIDContext(context.Background(), id)
+}
+
+// SLAGetByIDContext is like SLAGetByID but uses ctx for the underlying API calls.
+func (api *API) SLAGetByIDContext(ctx context.Context, id string) (res *SLA, err error) {
+	slas, err := api.SLAsGetContext(ctx, Params{"slaids": id})
 	if err != nil {
 		return
 	}
@@ -114,7 +126,12 @@ func (api *API) SLAGetByID(id string) (res *SLA, err error) {
 // SLAsCreate Wrapper for sla.create
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/sla/create
 func (api *API) SLAsCreate(slas SLAs) (err error) {
-	response, err := api.CallWithError("sla.create", slas)
+	return api.SLAsCreateContext(context.Background(), slas)
+}
+
+// SLAsCreateContext is like SLAsCreate but uses ctx for the underlying API calls.
+func (api *API) SLAsCreateContext(ctx context.Context, slas SLAs) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "sla.create", slas)
 	if err != nil {
 		return
 	}
@@ -130,7 +147,12 @@ func (api *API) SLAsCreate(slas SLAs) (err error) {
 // SLAsUpdate Wrapper for sla.update
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/sla/update
 func (api *API) SLAsUpdate(slas SLAs) (err error) {
-	_, err = api.CallWithError("sla.update", slas)
+	return api.SLAsUpdateContext(context.Background(), slas)
+}
+
+// SLAsUpdateContext is like SLAsUpdate but uses ctx for the underlying API calls.
+func (api *API) SLAsUpdateContext(ctx context.Context, slas SLAs) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "sla.update", slas)
 	return
 }
 
@@ -138,12 +160,17 @@ func (api *API) SLAsUpdate(slas SLAs) (err error) {
 // Cleans SLAID in all slas elements if call succeeds.
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/sla/delete
 func (api *API) SLAsDelete(slas SLAs) (err error) {
+	return api.SLAsDeleteContext(context.Background(), slas)
+}
+
+// SLAsDeleteContext is like SLAsDelete but uses ctx for the underlying API calls.
+func (api *API) SLAsDeleteContext(ctx context.Context, slas SLAs) (err error) {
 	ids := make([]string, len(slas))
 	for i, sla := range slas {
 		ids[i] = sla.SLAID
 	}
 
-	err = api.SLAsDeleteByIds(ids)
+	err = api.SLAsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range slas {
 			slas[i].SLAID = ""
@@ -155,7 +182,12 @@ func (api *API) SLAsDelete(slas SLAs) (err error) {
 // SLAsDeleteByIds Wrapper for sla.delete
 // https://www.zabbix.com/documentation/6.0/en/manual/api/reference/sla/delete
 func (api *API) SLAsDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("sla.delete", ids)
+	return api.SLAsDeleteByIdsContext(context.Background(), ids)
+}
+
+// SLAsDeleteByIdsContext is like SLAsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) SLAsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "sla.delete", ids)
 	if err != nil {
 		return
 	}
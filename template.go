@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 // Template represent Zabbix Template type returned from Zabbix API
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/template/object
 type Template struct {
@@ -29,16 +31,26 @@ type TemplateIDs []TemplateID
 // TemplatesGet Wrapper for template.get
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/get
 func (api *API) TemplatesGet(params Params) (res Templates, err error) {
+	return api.TemplatesGetContext(context.Background(), params)
+}
+
+// TemplatesGetContext is like TemplatesGet but uses ctx for the underlying API calls.
+func (api *API) TemplatesGetContext(ctx context.Context, params Params) (res Templates, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("template.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "template.get", params, &res)
 	return
 }
 
 // TemplateGetByID Gets template by Id only if there is exactly 1 matching template.
 func (api *API) TemplateGetByID(id string) (template *Template, err error) {
-	templates, err := api.TemplatesGet(Params{"templateids": id})
+	return api.TemplateGetByIDContext(context.Background(), id)
+}
+
+// TemplateGetByIDContext is like TemplateGetByID but uses ctx for the underlying API calls.
+func (api *API) TemplateGetByIDContext(ctx context.Context, id string) (template *Template, err error) {
+	templates, err := api.TemplatesGetContext(ctx, Params{"templateids": id})
 	if err != nil {
 		return
 	}
@@ -55,7 +67,12 @@ func (api *API) TemplateGetByID(id string) (template *Template, err error) {
 // TemplatesCreate Wrapper for template.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/create
 func (api *API) TemplatesCreate(templates Templates) (err error) {
-	response, err := api.CallWithError("template.create", templates)
+	return api.TemplatesCreateContext(context.Background(), templates)
+}
+
+// TemplatesCreateContext is like TemplatesCreate but uses ctx for the underlying API calls.
+func (api *API) TemplatesCreateContext(ctx context.Context, templates Templates) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "template.create", templates)
 	if err != nil {
 		return
 	}
@@ -71,7 +88,12 @@ func (api *API) TemplatesCreate(templates Templates) (err error) {
 // TemplatesUpdate Wrapper for template.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/update
 func (api *API) TemplatesUpdate(templates Templates) (err error) {
-	_, err = api.CallWithError("template.update", templates)
+	return api.TemplatesUpdateContext(context.Background(), templates)
+}
+
+// TemplatesUpdateContext is like TemplatesUpdate but uses ctx for the underlying API calls.
+func (api *API) TemplatesUpdateContext(ctx context.Context, templates Templates) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "template.update", templates)
 	return
 }
 
@@ -79,12 +101,17 @@ func (api *API) TemplatesUpdate(templates Templates) (err error) {
 // Cleans ApplicationID in all apps elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/delete
 func (api *API) TemplatesDelete(templates Templates) (err error) {
+	return api.TemplatesDeleteContext(context.Background(), templates)
+}
+
+// TemplatesDeleteContext is like TemplatesDelete but uses ctx for the underlying API calls.
+func (api *API) TemplatesDeleteContext(ctx context.Context, templates Templates) (err error) {
 	templatesIds := make([]string, len(templates))
 	for i, template := range templates {
 		templatesIds[i] = template.TemplateID
 	}
 
-	err = api.TemplatesDeleteByIds(templatesIds)
+	err = api.TemplatesDeleteByIdsContext(ctx, templatesIds)
 	if err == nil {
 		for i := range templates {
 			templates[i].TemplateID = ""
@@ -97,7 +124,12 @@ func (api *API) TemplatesDelete(templates Templates) (err error) {
 // Use template's id to delete the template
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/template/delete
 func (api *API) TemplatesDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("template.delete", ids)
+	return api.TemplatesDeleteByIdsContext(context.Background(), ids)
+}
+
+// TemplatesDeleteByIdsContext is like TemplatesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) TemplatesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "template.delete", ids)
 	if err != nil {
 		return
 	}
@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 // TemplateGroup represent Zabbix template group object
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templategroup/object
 type TemplateGroup struct {
@@ -21,16 +23,26 @@ type TemplateGroupIDs []TemplateGroupID
 // TemplateGroupsGet Wrapper for templategroup.get
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templategroup/get
 func (api *API) TemplateGroupsGet(params Params) (res TemplateGroups, err error) {
+	return api.TemplateGroupsGetContext(context.Background(), params)
+}
+
+// TemplateGroupsGetContext is like TemplateGroupsGet but uses ctx for the underlying API calls.
+func (api *API) TemplateGroupsGetContext(ctx context.Context, params Params) (res TemplateGroups, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("templategroup.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "templategroup.get", params, &res)
 	return
 }
 
 // TemplateGroupGetByID Gets template group by Id only if there is exactly 1 matching template group.
 func (api *API) TemplateGroupGetByID(id string) (res *TemplateGroup, err error) {
-	groups, err := api.TemplateGroupsGet(Params{"groupids": id})
+	return api.TemplateGroupGetByIDContext(context.Background(), id)
+}
+
+// TemplateGroupGetByIDContext is like TemplateGroupGetByID but uses ctx for the underlying API calls.
+func (api *API) TemplateGroupGetByIDContext(ctx context.Context, id string) (res *TemplateGroup, err error) {
+	groups, err := api.TemplateGroupsGetContext(ctx, Params{"groupids": id})
 	if err != nil {
 		return
 	}
@@ -47,7 +59,12 @@ func (api *API) TemplateGroupGetByID(id string) (res *TemplateGroup, err error)
 // TemplateGroupsCreate Wrapper for templategroup.create
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templategroup/create
 func (api *API) TemplateGroupsCreate(templateGroups TemplateGroups) (err error) {
-	response, err := api.CallWithError("templategroup.create", templateGroups)
+	return api.TemplateGroupsCreateContext(context.Background(), templateGroups)
+}
+
+// TemplateGroupsCreateContext is like TemplateGroupsCreate but uses ctx for the underlying API calls.
+func (api *API) TemplateGroupsCreateContext(ctx context.Context, templateGroups TemplateGroups) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "templategroup.create", templateGroups)
 	if err != nil {
 		return
 	}
@@ -63,7 +80,12 @@ func (api *API) TemplateGroupsCreate(templateGroups TemplateGroups) (err error)
 // TemplateGroupsUpdate Wrapper for templategroup.update
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templategroup/update
 func (api *API) TemplateGroupsUpdate(templateGroups TemplateGroups) (err error) {
-	_, err = api.CallWithError("templategroup.update", templateGroups)
+	return api.TemplateGroupsUpdateContext(context.Background(), templateGroups)
+}
+
+// TemplateGroupsUpdateContext is like TemplateGroupsUpdate but uses ctx for the underlying API calls.
+func (api *API) TemplateGroupsUpdateContext(ctx context.Context, templateGroups TemplateGroups) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "templategroup.update", templateGroups)
 	return
 }
 
@@ -71,12 +93,17 @@ func (api *API) TemplateGroupsUpdate(templateGroups TemplateGroups) (err error)
 // Cleans GroupId in all templateGroups elements if call succeed.
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templategroup/delete
 func (api *API) TemplateGroupsDelete(templateGroups TemplateGroups) (err error) {
+	return api.TemplateGroupsDeleteContext(context.Background(), templateGroups)
+}
+
+// TemplateGroupsDeleteContext is like TemplateGroupsDelete but uses ctx for the underlying API calls.
+func (api *API) TemplateGroupsDeleteContext(ctx context.Context, templateGroups TemplateGroups) (err error) {
 	ids := make([]string, len(templateGroups))
 	for i, group := range templateGroups {
 		ids[i] = group.GroupID
 	}
 
-	err = api.TemplateGroupsDeleteByIds(ids)
+	err = api.TemplateGroupsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range templateGroups {
 			templateGroups[i].GroupID = ""
@@ -88,7 +115,12 @@ func (api *API) TemplateGroupsDelete(templateGroups TemplateGroups) (err error)
 // TemplateGroupsDeleteByIds Wrapper for templategroup.delete
 // https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templategroup/delete
 func (api *API) TemplateGroupsDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("templategroup.delete", ids)
+	return api.TemplateGroupsDeleteByIdsContext(context.Background(), ids)
+}
+
+// TemplateGroupsDeleteByIdsContext is like TemplateGroupsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) TemplateGroupsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "templategroup.delete", ids)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,145 @@
+package zabbix
+
+import (
+	"context"
+)
+
+// TemplateDashboard represent Zabbix template dashboard object
+// Widgets of template dashboards can only reference objects of their template.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templatedashboard/object
+type TemplateDashboard struct {
+	DashboardID string `json:"dashboardid,omitempty"`
+	Name        string `json:"name"`
+	// TemplateID is required on create and can not be changed
+	TemplateID string `json:"templateid,omitempty"`
+	// DisplayPeriod default page display period in seconds in slideshows, zero uses the server default
+	DisplayPeriod int            `json:"display_period,string,omitempty"`
+	AutoStart     int            `json:"auto_start,string"`
+	UUID          string         `json:"uuid,omitempty"`
+	Pages         DashboardPages `json:"pages,omitempty"`
+}
+
+// TemplateDashboards is an array of TemplateDashboard
+type TemplateDashboards []TemplateDashboard
+
+// TemplateDashboardsGet Wrapper for templatedashboard.get
+// Selects pages unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templatedashboard/get
+func (api *API) TemplateDashboardsGet(params Params) (res TemplateDashboards, err error) {
+	return api.TemplateDashboardsGetContext(context.Background(), params)
+}
+
+// TemplateDashboardsGetContext is like TemplateDashboardsGet but uses ctx for the underlying API calls.
+func (api *API) TemplateDashboardsGetContext(ctx context.Context, params Params) (res TemplateDashboards, err error) {
+	for _, key := range []string{"output", "selectPages"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "templatedashboard.get", params, &res)
+	return
+}
+
+// TemplateDashboardGetByID Gets template dashboard by ID only if there is exactly 1 matching dashboard.
+func (api *API) TemplateDashboardGetByID(id string) (res *TemplateDashboard, err error) {
+	return api.TemplateDashboardGetByIDContext(context.Background(), id)
+}
+
+// TemplateDashboardGetByIDContext is like TemplateDashboardGetByID but uses ctx for the underlying API calls.
+func (api *API) TemplateDashboardGetByIDContext(ctx context.Context, id string) (res *TemplateDashboard, err error) {
+	dashboards, err := api.TemplateDashboardsGetContext(ctx, Params{"dashboardids": id})
+	if err != nil {
+		return
+	}
+
+	if len(dashboards) == 1 {
+		res = &dashboards[0]
+	} else {
+		e := ExpectedOneResult(len(dashboards))
+		err = &e
+	}
+	return
+}
+
+// TemplateDashboardsCreate Wrapper for templatedashboard.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templatedashboard/create
+func (api *API) TemplateDashboardsCreate(dashboards TemplateDashboards) (err error) {
+	return api.TemplateDashboardsCreateContext(context.Background(), dashboards)
+}
+
+// TemplateDashboardsCreateContext is like TemplateDashboardsCreate but uses ctx for the underlying API calls.
+func (api *API) TemplateDashboardsCreateContext(ctx context.Context, dashboards TemplateDashboards) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "templatedashboard.create", dashboards)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	dashboardids := result["dashboardids"].([]interface{})
+	for i, id := range dashboardids {
+		dashboards[i].DashboardID = id.(string)
+	}
+	return
+}
+
+// TemplateDashboardsUpdate Wrapper for templatedashboard.update
+// TemplateID is not sent, as Zabbix rejects it on update.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templatedashboard/update
+func (api *API) TemplateDashboardsUpdate(dashboards TemplateDashboards) (err error) {
+	return api.TemplateDashboardsUpdateContext(context.Background(), dashboards)
+}
+
+// TemplateDashboardsUpdateContext is like TemplateDashboardsUpdate but uses ctx for the underlying API calls.
+func (api *API) TemplateDashboardsUpdateContext(ctx context.Context, dashboards TemplateDashboards) (err error) {
+	update := make(TemplateDashboards, len(dashboards))
+	for i, dashboard := range dashboards {
+		dashboard.TemplateID = ""
+		update[i] = dashboard
+	}
+	_, err = api.CallWithErrorContext(ctx, "templatedashboard.update", update)
+	return
+}
+
+// TemplateDashboardsDelete Wrapper for templatedashboard.delete
+// Cleans DashboardID in all dashboards elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templatedashboard/delete
+func (api *API) TemplateDashboardsDelete(dashboards TemplateDashboards) (err error) {
+	return api.TemplateDashboardsDeleteContext(context.Background(), dashboards)
+}
+
+// TemplateDashboardsDeleteContext is like TemplateDashboardsDelete but uses ctx for the underlying API calls.
+func (api *API) TemplateDashboardsDeleteContext(ctx context.Context, dashboards TemplateDashboards) (err error) {
+	ids := make([]string, len(dashboards))
+	for i, dashboard := range dashboards {
+		ids[i] = dashboard.DashboardID
+	}
+
+	err = api.TemplateDashboardsDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range dashboards {
+			dashboards[i].DashboardID = ""
+		}
+	}
+	return
+}
+
+// TemplateDashboardsDeleteByIds Wrapper for templatedashboard.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/templatedashboard/delete
+func (api *API) TemplateDashboardsDeleteByIds(ids []string) (err error) {
+	return api.TemplateDashboardsDeleteByIdsContext(context.Background(), ids)
+}
+
+// TemplateDashboardsDeleteByIdsContext is like TemplateDashboardsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) TemplateDashboardsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "templatedashboard.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	dashboardids := result["dashboardids"].([]interface{})
+	if len(ids) != len(dashboardids) {
+		err = &ExpectedMore{len(ids), len(dashboardids)}
+	}
+	return
+}
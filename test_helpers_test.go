@@ -1,25 +1,34 @@
 package zabbix_test
 
 import (
-	"strings"
+	"errors"
 	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+	"github.com/kgeroczi/go-zabbix-api/zabbixtest"
 )
 
 func maybeSkipRestricted(t *testing.T, err error) bool {
-	if err == nil {
-		return false
-	}
-
-	msg := strings.ToLower(err.Error())
-	if strings.Contains(msg, "permission") ||
-		strings.Contains(msg, "access denied") ||
-		strings.Contains(msg, "no permissions") ||
-		strings.Contains(msg, "cannot be performed") ||
-		strings.Contains(msg, "insufficient") ||
-		strings.Contains(msg, "not allowed") {
+	if errors.Is(err, zapi.ErrPermissionDenied) {
 		t.Skipf("skipping because operation is restricted in this environment: %v", err)
 		return true
 	}
-
 	return false
 }
+
+// getFakeAPI returns an API logged in to a fresh zabbixtest fake server.
+func getFakeAPI(t *testing.T) (*zapi.API, *zabbixtest.Server) {
+	t.Helper()
+
+	srv := zabbixtest.NewServer()
+	t.Cleanup(srv.Close)
+
+	api, err := zapi.NewAPI(zapi.Config{Url: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Login(zabbixtest.DefaultUser, zabbixtest.DefaultPassword); err != nil {
+		t.Fatal(err)
+	}
+	return api, srv
+}
@@ -0,0 +1,244 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// TokenStatusType status of an API token
+// see "status" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/token/object
+type TokenStatusType int
+
+const (
+	// TokenEnabled token is enabled
+	TokenEnabled TokenStatusType = 0
+	// TokenDisabled token is disabled
+	TokenDisabled TokenStatusType = 1
+)
+
+// Token represent Zabbix API token object
+// The token string itself is only returned by TokensGenerate.
+// Zero times mean never: a token without ExpiresAt does not expire.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/token/object
+type Token struct {
+	TokenID     string          `json:"tokenid,omitempty"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	UserID      string          `json:"userid,omitempty"`
+	Status      TokenStatusType `json:"status,string"`
+	ExpiresAt   time.Time       `json:"-"`
+
+	// Fields below are read only
+	LastAccess    time.Time `json:"-"`
+	CreatedAt     time.Time `json:"-"`
+	CreatorUserID string    `json:"-"`
+}
+
+// Tokens is an array of Token
+type Tokens []Token
+
+// GeneratedToken is a token string returned by TokensGenerate
+type GeneratedToken struct {
+	TokenID string `json:"tokenid"`
+	Token   string `json:"token"`
+}
+
+// GeneratedTokens is an array of GeneratedToken
+type GeneratedTokens []GeneratedToken
+
+// timeFromUnix converts a Zabbix timestamp, where 0 means never, to time.Time.
+func timeFromUnix(sec int64) time.Time {
+	if sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// unixFromTime converts t to a Zabbix timestamp, where 0 means never.
+func unixFromTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// MarshalJSON encodes ExpiresAt as a Unix timestamp and leaves out read only fields.
+func (t Token) MarshalJSON() ([]byte, error) {
+	type plain Token
+	return json.Marshal(struct {
+		plain
+		ExpiresAt int64 `json:"expires_at,string"`
+	}{plain(t), unixFromTime(t.ExpiresAt)})
+}
+
+// UnmarshalJSON decodes the Unix timestamps of t.
+func (t *Token) UnmarshalJSON(data []byte) error {
+	type plain Token
+	aux := struct {
+		*plain
+		ExpiresAt     int64  `json:"expires_at,string"`
+		LastAccess    int64  `json:"lastaccess,string"`
+		CreatedAt     int64  `json:"created_at,string"`
+		CreatorUserID string `json:"creator_userid"`
+	}{plain: (*plain)(t)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.ExpiresAt = timeFromUnix(aux.ExpiresAt)
+	t.LastAccess = timeFromUnix(aux.LastAccess)
+	t.CreatedAt = timeFromUnix(aux.CreatedAt)
+	t.CreatorUserID = aux.CreatorUserID
+	return nil
+}
+
+// ExpiresBefore reports whether t expires before at. Tokens without expiry never do.
+func (t Token) ExpiresBefore(at time.Time) bool {
+	return !t.ExpiresAt.IsZero() && t.ExpiresAt.Before(at)
+}
+
+// TokensGet Wrapper for token.get
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/token/get
+func (api *API) TokensGet(params Params) (res Tokens, err error) {
+	return api.TokensGetContext(context.Background(), params)
+}
+
+// TokensGetContext is like TokensGet but uses ctx for the underlying API calls.
+func (api *API) TokensGetContext(ctx context.Context, params Params) (res Tokens, err error) {
+	if _, present := params["output"]; !present {
+		params["output"] = "extend"
+	}
+	err = api.CallWithErrorParseContext(ctx, "token.get", params, &res)
+	return
+}
+
+// TokensGetByUserIds Gets API tokens of the given users.
+func (api *API) TokensGetByUserIds(ids []string) (res Tokens, err error) {
+	return api.TokensGetByUserIdsContext(context.Background(), ids)
+}
+
+// TokensGetByUserIdsContext is like TokensGetByUserIds but uses ctx for the underlying API calls.
+func (api *API) TokensGetByUserIdsContext(ctx context.Context, ids []string) (res Tokens, err error) {
+	return api.TokensGetContext(ctx, Params{"userids": ids})
+}
+
+// TokenGetByID Gets API token by ID only if there is exactly 1 matching token.
+func (api *API) TokenGetByID(id string) (res *Token, err error) {
+	return api.TokenGetByIDContext(context.Background(), id)
+}
+
+// TokenGetByIDContext is like TokenGetByID but uses ctx for the underlying API calls.
+func (api *API) TokenGetByIDContext(ctx context.Context, id string) (res *Token, err error) {
+	tokens, err := api.TokensGetContext(ctx, Params{"tokenids": id})
+	if err != nil {
+		return
+	}
+
+	if len(tokens) == 1 {
+		res = &tokens[0]
+	} else {
+		e := ExpectedOneResult(len(tokens))
+		err = &e
+	}
+	return
+}
+
+// TokensCreate Wrapper for token.create
+// The tokens can not be used before TokensGenerate creates their token strings.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/token/create
+func (api *API) TokensCreate(tokens Tokens) (err error) {
+	return api.TokensCreateContext(context.Background(), tokens)
+}
+
+// TokensCreateContext is like TokensCreate but uses ctx for the underlying API calls.
+func (api *API) TokensCreateContext(ctx context.Context, tokens Tokens) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "token.create", tokens)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	tokenids := result["tokenids"].([]interface{})
+	for i, id := range tokenids {
+		tokens[i].TokenID = id.(string)
+	}
+	return
+}
+
+// TokensUpdate Wrapper for token.update
+// UserID is not sent, the owner of a token can not be changed.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/token/update
+func (api *API) TokensUpdate(tokens Tokens) (err error) {
+	return api.TokensUpdateContext(context.Background(), tokens)
+}
+
+// TokensUpdateContext is like TokensUpdate but uses ctx for the underlying API calls.
+func (api *API) TokensUpdateContext(ctx context.Context, tokens Tokens) (err error) {
+	update := make(Tokens, len(tokens))
+	for i, t := range tokens {
+		t.UserID = ""
+		update[i] = t
+	}
+	_, err = api.CallWithErrorContext(ctx, "token.update", update)
+	return
+}
+
+// TokensGenerate Wrapper for token.generate
+// Generates new token strings for the given token IDs, invalidating the previous ones.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/token/generate
+func (api *API) TokensGenerate(ids []string) (res GeneratedTokens, err error) {
+	return api.TokensGenerateContext(context.Background(), ids)
+}
+
+// TokensGenerateContext is like TokensGenerate but uses ctx for the underlying API calls.
+func (api *API) TokensGenerateContext(ctx context.Context, ids []string) (res GeneratedTokens, err error) {
+	err = api.CallWithErrorParseContext(ctx, "token.generate", ids, &res)
+	if err == nil && len(ids) != len(res) {
+		err = &ExpectedMore{len(ids), len(res)}
+	}
+	return
+}
+
+// TokensDelete Wrapper for token.delete
+// Cleans TokenID in all tokens elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/token/delete
+func (api *API) TokensDelete(tokens Tokens) (err error) {
+	return api.TokensDeleteContext(context.Background(), tokens)
+}
+
+// TokensDeleteContext is like TokensDelete but uses ctx for the underlying API calls.
+func (api *API) TokensDeleteContext(ctx context.Context, tokens Tokens) (err error) {
+	ids := make([]string, len(tokens))
+	for i, token := range tokens {
+		ids[i] = token.TokenID
+	}
+
+	err = api.TokensDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range tokens {
+			tokens[i].TokenID = ""
+		}
+	}
+	return
+}
+
+// TokensDeleteByIds Wrapper for token.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/token/delete
+func (api *API) TokensDeleteByIds(ids []string) (err error) {
+	return api.TokensDeleteByIdsContext(context.Background(), ids)
+}
+
+// TokensDeleteByIdsContext is like TokensDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) TokensDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "token.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	tokenids := result["tokenids"].([]interface{})
+	if len(ids) != len(tokenids) {
+		err = &ExpectedMore{len(ids), len(tokenids)}
+	}
+	return
+}
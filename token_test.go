@@ -0,0 +1,90 @@
+package zabbix_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+	"github.com/kgeroczi/go-zabbix-api/zabbixtest"
+)
+
+func TestTokensGet(t *testing.T) {
+	api := getAPI(t)
+
+	if _, err := api.TokensGet(zapi.Params{}); err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+}
+
+func TestTokensFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	expires := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	tokens := zapi.Tokens{
+		{Name: "backup", UserID: "1", ExpiresAt: expires},
+		{Name: "monitoring", UserID: "1", Status: zapi.TokenDisabled},
+	}
+	if err := api.TokensCreate(tokens); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := api.TokenGetByID(tokens[0].TokenID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.ExpiresAt.Equal(expires) || got.UserID != "1" {
+		t.Errorf("unexpected token %#v", got)
+	}
+	if !got.ExpiresBefore(expires.Add(time.Hour)) || got.ExpiresBefore(expires.Add(-time.Hour)) {
+		t.Errorf("unexpected expiry check for %s", got.ExpiresAt)
+	}
+
+	got.ExpiresAt = time.Time{}
+	got.Status = zapi.TokenDisabled
+	if err = api.TokensUpdate(zapi.Tokens{*got}); err != nil {
+		t.Fatal(err)
+	}
+	list, err := api.TokensGetByUserIds([]string{"1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || !list[0].ExpiresAt.IsZero() || list[0].ExpiresBefore(time.Now()) || list[0].Status != zapi.TokenDisabled {
+		t.Errorf("unexpected tokens %#v", list)
+	}
+	if obj := srv.Objects("token")[0]; obj["userid"] != "1" {
+		t.Errorf("owner changed by update: %v", obj["userid"])
+	}
+
+	generated, err := api.TokensGenerate([]string{tokens[1].TokenID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(generated) != 1 || len(generated[0].Token) != 64 {
+		t.Fatalf("unexpected generated tokens %#v", generated)
+	}
+
+	service, err := zapi.NewAPI(zapi.Config{Url: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.Token(generated[0].Token)
+	session, err := service.CheckAuthentication()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Username != zabbixtest.DefaultUser {
+		t.Errorf("unexpected session %#v", session)
+	}
+
+	if err = api.TokensDelete(tokens); err != nil {
+		t.Fatal(err)
+	}
+	if tokens[0].TokenID != "" {
+		t.Error("token ID not cleared after delete")
+	}
+	if _, err = service.HostsGet(zapi.Params{}); !errors.Is(err, zapi.ErrSessionExpired) {
+		t.Errorf("revoked token: unexpected error %v", err)
+	}
+}
@@ -1,5 +1,7 @@
 package zabbix
 
+import "context"
+
 type (
 	// SeverityType of a trigger
 	// Zabbix severity see : https://www.zabbix.com/documentation/3.2/manual/api/reference/trigger/object
@@ -100,23 +102,37 @@ type Triggers []Trigger
 // TriggersGet Wrapper for trigger.get
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/trigger/get
 func (api *API) TriggersGet(params Params) (res Triggers, err error) {
+	return api.TriggersGetContext(context.Background(), params)
+}
+
+// TriggersGetContext is like TriggersGet but uses ctx for the underlying API calls.
+func (api *API) TriggersGetContext(ctx context.Context, params Params) (res Triggers, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("trigger.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "trigger.get", params, &res)
 	return
 }
 func (api *API) ProtoTriggersGet(params Params) (res Triggers, err error) {
+	return api.ProtoTriggersGetContext(context.Background(), params)
+}
+
+func (api *API) ProtoTriggersGetContext(ctx context.Context, params Params) (res Triggers, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("triggerprototype.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "triggerprototype.get", params, &res)
 	return
 }
 
 // TriggerGetByID Gets trigger by Id only if there is exactly 1 matching host.
 func (api *API) TriggerGetByID(id string) (res *Trigger, err error) {
-	triggers, err := api.TriggersGet(Params{"triggerids": id})
+	return api.TriggerGetByIDContext(context.Background(), id)
+}
+
+// TriggerGetByIDContext is like TriggerGetByID but uses ctx for the underlying API calls.
+func (api *API) TriggerGetByIDContext(ctx context.Context, id string) (res *Trigger, err error) {
+	triggers, err := api.TriggersGetContext(ctx, Params{"triggerids": id})
 	if err != nil {
 		return
 	}
@@ -130,7 +146,11 @@ func (api *API) TriggerGetByID(id string) (res *Trigger, err error) {
 	return
 }
 func (api *API) ProtoTriggerGetByID(id string) (res *Trigger, err error) {
-	triggers, err := api.ProtoTriggersGet(Params{"triggerids": id})
+	return api.ProtoTriggerGetByIDContext(context.Background(), id)
+}
+
+func (api *API) ProtoTriggerGetByIDContext(ctx context.Context, id string) (res *Trigger, err error) {
+	triggers, err := api.ProtoTriggersGetContext(ctx, Params{"triggerids": id})
 	if err != nil {
 		return
 	}
@@ -147,7 +167,12 @@ func (api *API) ProtoTriggerGetByID(id string) (res *Trigger, err error) {
 // TriggersCreate Wrapper for trigger.create
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/trigger/create
 func (api *API) TriggersCreate(triggers Triggers) (err error) {
-	response, err := api.CallWithError("trigger.create", triggers)
+	return api.TriggersCreateContext(context.Background(), triggers)
+}
+
+// TriggersCreateContext is like TriggersCreate but uses ctx for the underlying API calls.
+func (api *API) TriggersCreateContext(ctx context.Context, triggers Triggers) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "trigger.create", triggers)
 	if err != nil {
 		return
 	}
@@ -160,7 +185,11 @@ func (api *API) TriggersCreate(triggers Triggers) (err error) {
 	return
 }
 func (api *API) ProtoTriggersCreate(triggers Triggers) (err error) {
-	response, err := api.CallWithError("triggerprototype.create", triggers)
+	return api.ProtoTriggersCreateContext(context.Background(), triggers)
+}
+
+func (api *API) ProtoTriggersCreateContext(ctx context.Context, triggers Triggers) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "triggerprototype.create", triggers)
 	if err != nil {
 		return
 	}
@@ -176,11 +205,20 @@ func (api *API) ProtoTriggersCreate(triggers Triggers) (err error) {
 // TriggersUpdate Wrapper for trigger.update
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/trigger/update
 func (api *API) TriggersUpdate(triggers Triggers) (err error) {
-	_, err = api.CallWithError("trigger.update", triggers)
+	return api.TriggersUpdateContext(context.Background(), triggers)
+}
+
+// TriggersUpdateContext is like TriggersUpdate but uses ctx for the underlying API calls.
+func (api *API) TriggersUpdateContext(ctx context.Context, triggers Triggers) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "trigger.update", triggers)
 	return
 }
 func (api *API) ProtoTriggersUpdate(triggers Triggers) (err error) {
-	_, err = api.CallWithError("triggerprototype.update", triggers)
+	return api.ProtoTriggersUpdateContext(context.Background(), triggers)
+}
+
+func (api *API) ProtoTriggersUpdateContext(ctx context.Context, triggers Triggers) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "triggerprototype.update", triggers)
 	return
 }
 
@@ -188,12 +226,17 @@ func (api *API) ProtoTriggersUpdate(triggers Triggers) (err error) {
 // Cleans ItemId in all triggers elements if call succeed.
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/trigger/delete
 func (api *API) TriggersDelete(triggers Triggers) (err error) {
+	return api.TriggersDeleteContext(context.Background(), triggers)
+}
+
+// TriggersDeleteContext is like TriggersDelete but uses ctx for the underlying API calls.
+func (api *API) TriggersDeleteContext(ctx context.Context, triggers Triggers) (err error) {
 	ids := make([]string, len(triggers))
 	for i, trigger := range triggers {
 		ids[i] = trigger.TriggerID
 	}
 
-	err = api.TriggersDeleteByIds(ids)
+	err = api.TriggersDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range triggers {
 			triggers[i].TriggerID = ""
@@ -202,12 +245,16 @@ func (api *API) TriggersDelete(triggers Triggers) (err error) {
 	return
 }
 func (api *API) ProtoTriggersDelete(triggers Triggers) (err error) {
+	return api.ProtoTriggersDeleteContext(context.Background(), triggers)
+}
+
+func (api *API) ProtoTriggersDeleteContext(ctx context.Context, triggers Triggers) (err error) {
 	ids := make([]string, len(triggers))
 	for i, trigger := range triggers {
 		ids[i] = trigger.TriggerID
 	}
 
-	err = api.ProtoTriggersDeleteByIds(ids)
+	err = api.ProtoTriggersDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range triggers {
 			triggers[i].TriggerID = ""
@@ -219,7 +266,12 @@ func (api *API) ProtoTriggersDelete(triggers Triggers) (err error) {
 // TriggersDeleteByIds Wrapper for trigger.delete
 // https://www.zabbix.com/documentation/3.2/manual/api/reference/trigger/delete
 func (api *API) TriggersDeleteByIds(ids []string) (err error) {
-	deleteIds, err := api.TriggersDeleteIDs(ids)
+	return api.TriggersDeleteByIdsContext(context.Background(), ids)
+}
+
+// TriggersDeleteByIdsContext is like TriggersDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) TriggersDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	deleteIds, err := api.TriggersDeleteIDsContext(ctx, ids)
 	if err != nil {
 		return
 	}
@@ -230,7 +282,11 @@ func (api *API) TriggersDeleteByIds(ids []string) (err error) {
 	return
 }
 func (api *API) ProtoTriggersDeleteByIds(ids []string) (err error) {
-	deleteIds, err := api.ProtoTriggersDeleteIDs(ids)
+	return api.ProtoTriggersDeleteByIdsContext(context.Background(), ids)
+}
+
+func (api *API) ProtoTriggersDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	deleteIds, err := api.ProtoTriggersDeleteIDsContext(ctx, ids)
 	if err != nil {
 		return
 	}
@@ -244,7 +300,12 @@ func (api *API) ProtoTriggersDeleteByIds(ids []string) (err error) {
 // TriggersDeleteIDs Wrapper for trigger.delete
 // return the id of the deleted trigger
 func (api *API) TriggersDeleteIDs(ids []string) (triggerids []interface{}, err error) {
-	response, err := api.CallWithError("trigger.delete", ids)
+	return api.TriggersDeleteIDsContext(context.Background(), ids)
+}
+
+// TriggersDeleteIDsContext is like TriggersDeleteIDs but uses ctx for the underlying API calls.
+func (api *API) TriggersDeleteIDsContext(ctx context.Context, ids []string) (triggerids []interface{}, err error) {
+	response, err := api.CallWithErrorContext(ctx, "trigger.delete", ids)
 	if err != nil {
 		return
 	}
@@ -262,7 +323,11 @@ func (api *API) TriggersDeleteIDs(ids []string) (triggerids []interface{}, err e
 	return
 }
 func (api *API) ProtoTriggersDeleteIDs(ids []string) (triggerids []interface{}, err error) {
-	response, err := api.CallWithError("triggerprototype.delete", ids)
+	return api.ProtoTriggersDeleteIDsContext(context.Background(), ids)
+}
+
+func (api *API) ProtoTriggersDeleteIDsContext(ctx context.Context, ids []string) (triggerids []interface{}, err error) {
+	response, err := api.CallWithErrorContext(ctx, "triggerprototype.delete", ids)
 	if err != nil {
 		return
 	}
@@ -1,5 +1,10 @@
 package zabbix
 
+import (
+	"context"
+	"encoding/json"
+)
+
 // User represent Zabbix user object
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/user/object
 type User struct {
@@ -10,11 +15,117 @@ type User struct {
 	Name     string       `json:"name"`
 	Surname  string       `json:"surname"`
 	Groups   usergroupids `json:"usrgrps"`
+	// Medias is only returned when requested with "selectMedias"
+	Medias UserMedias `json:"medias,omitempty"`
+
+	AutoLogin AutoLoginType `json:"autologin,string"`
+
+	// Fields below left empty keep the server default, e.g. the system language and time zone
+	AutoLogout  string `json:"autologout,omitempty"`
+	Lang        string `json:"lang,omitempty"`
+	Refresh     string `json:"refresh,omitempty"`
+	RowsPerPage int    `json:"rows_per_page,string,omitempty"`
+	// Theme like "blue-theme", "dark-theme", "hc-light" or "hc-dark"
+	Theme    string `json:"theme,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
+	URL      string `json:"url,omitempty"`
+	// UserDirectoryID of a provisioned user, "0" for internal authentication
+	UserDirectoryID string `json:"userdirectoryid,omitempty"`
 }
 
 // Users is an array of User
 type Users []User
 
+type (
+	// AutoLoginType whether the user is logged in automatically
+	// see "autologin" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/user/object
+	AutoLoginType int
+
+	// UserMediaStatusType status of a user media
+	// see "active" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/user/object#media
+	UserMediaStatusType int
+
+	// MediaSeverity bitmask of the trigger severities a user media is used for
+	// see "severity" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/user/object#media
+	MediaSeverity int
+)
+
+const (
+	// AutoLoginDisabled auto-login is disabled
+	AutoLoginDisabled AutoLoginType = 0
+	// AutoLoginEnabled auto-login is enabled
+	AutoLoginEnabled AutoLoginType = 1
+)
+
+const (
+	// UserMediaEnabled media is enabled
+	UserMediaEnabled UserMediaStatusType = 0
+	// UserMediaDisabled media is disabled
+	UserMediaDisabled UserMediaStatusType = 1
+)
+
+// MediaSeverityAll all trigger severities
+const MediaSeverityAll MediaSeverity = 63
+
+// MediaPeriodAlways active period covering the whole week
+const MediaPeriodAlways = "1-7,00:00-24:00"
+
+// MediaSeverities returns the bitmask of the given severities.
+func MediaSeverities(severities ...SeverityType) (res MediaSeverity) {
+	for _, s := range severities {
+		res |= 1 << uint(s)
+	}
+	return
+}
+
+// Has reports whether severity s is set in m.
+func (m MediaSeverity) Has(s SeverityType) bool {
+	return m&(1<<uint(s)) != 0
+}
+
+// MediaSendTo recipients of a user media.
+// Email media accept several addresses, other media types a single one.
+type MediaSendTo []string
+
+// MarshalJSON encodes a single recipient as a string and several as an array.
+func (s MediaSendTo) MarshalJSON() ([]byte, error) {
+	if len(s) == 1 {
+		return json.Marshal(s[0])
+	}
+	return json.Marshal([]string(s))
+}
+
+// UnmarshalJSON accepts a string or an array of strings.
+func (s *MediaSendTo) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*s = MediaSendTo{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*s = many
+	return nil
+}
+
+// UserMedia represent media of a Zabbix user
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/user/object#media
+type UserMedia struct {
+	MediaID     string              `json:"mediaid,omitempty"`
+	MediaTypeID string              `json:"mediatypeid"`
+	SendTo      MediaSendTo         `json:"sendto"`
+	Active      UserMediaStatusType `json:"active,string"`
+	// Severity zero leaves the server default of all severities
+	Severity MediaSeverity `json:"severity,omitempty,string"`
+	// Period when the media is active, e.g. MediaPeriodAlways
+	Period string `json:"period,omitempty"`
+}
+
+// UserMedias is an array of UserMedia
+type UserMedias []UserMedia
+
 // UserID represent Zabbix UserID
 type UserID struct {
 	UserID string `json:"userid"`
@@ -26,16 +137,26 @@ type userids []UserID
 // UsersGet Wrapper for user.get
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/user/get
 func (api *API) UsersGet(params Params) (res Users, err error) {
+	return api.UsersGetContext(context.Background(), params)
+}
+
+// UsersGetContext is like UsersGet but uses ctx for the underlying API calls.
+func (api *API) UsersGetContext(ctx context.Context, params Params) (res Users, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("user.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "user.get", params, &res)
 	return
 }
 
 // UserGetByID Gets user by Id only if there is exactly 1 matching user.
 func (api *API) UserGetByID(id string) (res *User, err error) {
-	groups, err := api.UsersGet(Params{"userids": id})
+	return api.UserGetByIDContext(context.Background(), id)
+}
+
+// UserGetByIDContext is like UserGetByID but uses ctx for the underlying API calls.
+func (api *API) UserGetByIDContext(ctx context.Context, id string) (res *User, err error) {
+	groups, err := api.UsersGetContext(ctx, Params{"userids": id})
 	if err != nil {
 		return
 	}
@@ -52,7 +173,12 @@ func (api *API) UserGetByID(id string) (res *User, err error) {
 // UsersCreate Wrapper for user.create
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/user/create
 func (api *API) UsersCreate(Users Users) (err error) {
-	response, err := api.CallWithError("user.create", Users)
+	return api.UsersCreateContext(context.Background(), Users)
+}
+
+// UsersCreateContext is like UsersCreate but uses ctx for the underlying API calls.
+func (api *API) UsersCreateContext(ctx context.Context, Users Users) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "user.create", Users)
 	if err != nil {
 		return
 	}
@@ -68,7 +194,12 @@ func (api *API) UsersCreate(Users Users) (err error) {
 // UsersUpdate Wrapper for user.update
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/user/update
 func (api *API) UsersUpdate(Users Users) (err error) {
-	_, err = api.CallWithError("user.update", Users)
+	return api.UsersUpdateContext(context.Background(), Users)
+}
+
+// UsersUpdateContext is like UsersUpdate but uses ctx for the underlying API calls.
+func (api *API) UsersUpdateContext(ctx context.Context, Users Users) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "user.update", Users)
 	return
 }
 
@@ -76,12 +207,17 @@ func (api *API) UsersUpdate(Users Users) (err error) {
 // Cleans UserID in all Users elements if call succeed.
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/user/delete
 func (api *API) UsersDelete(Users Users) (err error) {
+	return api.UsersDeleteContext(context.Background(), Users)
+}
+
+// UsersDeleteContext is like UsersDelete but uses ctx for the underlying API calls.
+func (api *API) UsersDeleteContext(ctx context.Context, Users Users) (err error) {
 	ids := make([]string, len(Users))
 	for i, user := range Users {
 		ids[i] = user.UserID
 	}
 
-	err = api.UsersDeleteByIds(ids)
+	err = api.UsersDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range Users {
 			Users[i].UserID = ""
@@ -93,7 +229,12 @@ func (api *API) UsersDelete(Users Users) (err error) {
 // UsersDeleteByIds Wrapper for user.delete
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/user/delete
 func (api *API) UsersDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("user.delete", ids)
+	return api.UsersDeleteByIdsContext(context.Background(), ids)
+}
+
+// UsersDeleteByIdsContext is like UsersDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) UsersDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "user.delete", ids)
 	if err != nil {
 		return
 	}
@@ -1,5 +1,10 @@
 package zabbix
 
+import (
+	"context"
+	"sort"
+)
+
 // UserGroupGroup represent Zabbix usergroup object
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/object
 type UserGroup struct {
@@ -10,11 +15,43 @@ type UserGroup struct {
 	Status                   int                  `json:"users_status,string"`
 	Permissions              usergrouppermissions `json:"hostgroup_rights,omitempty"`
 	TemplateGroupPermissions usergrouppermissions `json:"templategroup_rights,omitempty"`
+	// UserDirectoryID used for authentication of the group members, empty or "0" uses the default one
+	UserDirectoryID string `json:"userdirectoryid,omitempty"`
+	// MFAStatus nil is not sent, for servers before 7.0 that reject it
+	MFAStatus *MFAStatusType `json:"mfa_status,string,omitempty"`
+	// MFAID method used when MFAStatus is enabled, empty uses the default one
+	MFAID string `json:"mfaid,omitempty"`
+	// TagFilters is only returned when requested with "selectTagFilters"
+	TagFilters UserGroupTagFilters `json:"tag_filters,omitempty"`
+	// Users is only returned when requested with "selectUsers"
+	Users userids `json:"users,omitempty"`
 }
 
 // UserGroups is an array of UserGroup
 type UserGroups []UserGroup
 
+// MFAStatusType whether multi-factor authentication is required for group members
+// see "mfa_status" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/usergroup/object
+type MFAStatusType int
+
+const (
+	// MFADisabled multi-factor authentication is disabled
+	MFADisabled MFAStatusType = 0
+	// MFAEnabled multi-factor authentication is enabled
+	MFAEnabled MFAStatusType = 1
+)
+
+// UserGroupTagFilter restricts problem visibility of a host group to a tag, and value if set
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/usergroup/object#tag-based-permission
+type UserGroupTagFilter struct {
+	GroupID string `json:"groupid"`
+	Tag     string `json:"tag,omitempty"`
+	Value   string `json:"value,omitempty"`
+}
+
+// UserGroupTagFilters is an array of UserGroupTagFilter
+type UserGroupTagFilters []UserGroupTagFilter
+
 // UserGroupID represent Zabbix UserGroupID
 type UserGroupID struct {
 	UserGroupID string `json:"usrgrpid"`
@@ -36,16 +73,26 @@ type usergrouppermissions []UserGroupPermission
 // UserGroupsGet Wrapper for usergroup.get
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/get
 func (api *API) UserGroupsGet(params Params) (res UserGroups, err error) {
+	return api.UserGroupsGetContext(context.Background(), params)
+}
+
+// UserGroupsGetContext is like UserGroupsGet but uses ctx for the underlying API calls.
+func (api *API) UserGroupsGetContext(ctx context.Context, params Params) (res UserGroups, err error) {
 	if _, present := params["output"]; !present {
 		params["output"] = "extend"
 	}
-	err = api.CallWithErrorParse("usergroup.get", params, &res)
+	err = api.CallWithErrorParseContext(ctx, "usergroup.get", params, &res)
 	return
 }
 
 // UserGroupGetByID Gets usergroup by Id only if there is exactly 1 matching usergroup.
 func (api *API) UserGroupGetByID(id string) (res *UserGroup, err error) {
-	groups, err := api.UserGroupsGet(Params{"usrgrpids": id})
+	return api.UserGroupGetByIDContext(context.Background(), id)
+}
+
+// UserGroupGetByIDContext is like UserGroupGetByID but uses ctx for the underlying API calls.
+func (api *API) UserGroupGetByIDContext(ctx context.Context, id string) (res *UserGroup, err error) {
+	groups, err := api.UserGroupsGetContext(ctx, Params{"usrgrpids": id})
 	if err != nil {
 		return
 	}
@@ -62,7 +109,12 @@ func (api *API) UserGroupGetByID(id string) (res *UserGroup, err error) {
 // UserGroupsCreate Wrapper for usergroup.create
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/create
 func (api *API) UserGroupsCreate(UserGroups UserGroups) (err error) {
-	response, err := api.CallWithError("usergroup.create", UserGroups)
+	return api.UserGroupsCreateContext(context.Background(), UserGroups)
+}
+
+// UserGroupsCreateContext is like UserGroupsCreate but uses ctx for the underlying API calls.
+func (api *API) UserGroupsCreateContext(ctx context.Context, UserGroups UserGroups) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "usergroup.create", UserGroups)
 	if err != nil {
 		return
 	}
@@ -78,7 +130,12 @@ func (api *API) UserGroupsCreate(UserGroups UserGroups) (err error) {
 // UserGroupsUpdate Wrapper for usergroup.update
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/update
 func (api *API) UserGroupsUpdate(UserGroups UserGroups) (err error) {
-	_, err = api.CallWithError("usergroup.update", UserGroups)
+	return api.UserGroupsUpdateContext(context.Background(), UserGroups)
+}
+
+// UserGroupsUpdateContext is like UserGroupsUpdate but uses ctx for the underlying API calls.
+func (api *API) UserGroupsUpdateContext(ctx context.Context, UserGroups UserGroups) (err error) {
+	_, err = api.CallWithErrorContext(ctx, "usergroup.update", UserGroups)
 	return
 }
 
@@ -86,12 +143,17 @@ func (api *API) UserGroupsUpdate(UserGroups UserGroups) (err error) {
 // Cleans UserGroupID in all UserGroups elements if call succeed.
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/delete
 func (api *API) UserGroupsDelete(UserGroups UserGroups) (err error) {
+	return api.UserGroupsDeleteContext(context.Background(), UserGroups)
+}
+
+// UserGroupsDeleteContext is like UserGroupsDelete but uses ctx for the underlying API calls.
+func (api *API) UserGroupsDeleteContext(ctx context.Context, UserGroups UserGroups) (err error) {
 	ids := make([]string, len(UserGroups))
 	for i, usergroup := range UserGroups {
 		ids[i] = usergroup.UserGroupID
 	}
 
-	err = api.UserGroupsDeleteByIds(ids)
+	err = api.UserGroupsDeleteByIdsContext(ctx, ids)
 	if err == nil {
 		for i := range UserGroups {
 			UserGroups[i].UserGroupID = ""
@@ -103,7 +165,12 @@ func (api *API) UserGroupsDelete(UserGroups UserGroups) (err error) {
 // UserGroupsDeleteByIds Wrapper for usergroup.delete
 // https://www.zabbix.com/documentation/current/en/manual/api/reference/usergroup/delete
 func (api *API) UserGroupsDeleteByIds(ids []string) (err error) {
-	response, err := api.CallWithError("usergroup.delete", ids)
+	return api.UserGroupsDeleteByIdsContext(context.Background(), ids)
+}
+
+// UserGroupsDeleteByIdsContext is like UserGroupsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) UserGroupsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "usergroup.delete", ids)
 	if err != nil {
 		return
 	}
@@ -115,3 +182,67 @@ func (api *API) UserGroupsDeleteByIds(ids []string) (err error) {
 	}
 	return
 }
+
+// UserGroupsAddUsers Adds users to user groups, keeping their other members.
+// Zabbix only updates the full member list, so the current members are read first;
+// concurrent membership changes of the same groups may be lost.
+func (api *API) UserGroupsAddUsers(groupIDs, userIDs []string) (err error) {
+	return api.UserGroupsAddUsersContext(context.Background(), groupIDs, userIDs)
+}
+
+// UserGroupsAddUsersContext is like UserGroupsAddUsers but uses ctx for the underlying API calls.
+func (api *API) UserGroupsAddUsersContext(ctx context.Context, groupIDs, userIDs []string) (err error) {
+	return api.updateUserGroupMembers(ctx, groupIDs, func(members map[string]bool) {
+		for _, id := range userIDs {
+			members[id] = true
+		}
+	})
+}
+
+// UserGroupsRemoveUsers Removes users from user groups, keeping their other members.
+// See UserGroupsAddUsers about concurrent changes.
+func (api *API) UserGroupsRemoveUsers(groupIDs, userIDs []string) (err error) {
+	return api.UserGroupsRemoveUsersContext(context.Background(), groupIDs, userIDs)
+}
+
+// UserGroupsRemoveUsersContext is like UserGroupsRemoveUsers but uses ctx for the underlying API calls.
+func (api *API) UserGroupsRemoveUsersContext(ctx context.Context, groupIDs, userIDs []string) (err error) {
+	return api.updateUserGroupMembers(ctx, groupIDs, func(members map[string]bool) {
+		for _, id := range userIDs {
+			delete(members, id)
+		}
+	})
+}
+
+// updateUserGroupMembers applies change to the members of each group and sends only the member lists.
+func (api *API) updateUserGroupMembers(ctx context.Context, groupIDs []string, change func(members map[string]bool)) (err error) {
+	groups, err := api.UserGroupsGetContext(ctx, Params{
+		"output":      []string{"usrgrpid"},
+		"selectUsers": []string{"userid"},
+		"usrgrpids":   groupIDs,
+	})
+	if err != nil {
+		return
+	}
+	if len(groups) != len(groupIDs) {
+		return &ExpectedMore{len(groupIDs), len(groups)}
+	}
+
+	update := make([]Params, len(groups))
+	for i, group := range groups {
+		members := map[string]bool{}
+		for _, u := range group.Users {
+			members[u.UserID] = true
+		}
+		change(members)
+
+		users := make(userids, 0, len(members))
+		for id := range members {
+			users = append(users, UserID{id})
+		}
+		sort.Slice(users, func(a, b int) bool { return users[a].UserID < users[b].UserID })
+		update[i] = Params{"usrgrpid": group.UserGroupID, "users": users}
+	}
+	_, err = api.CallWithErrorContext(ctx, "usergroup.update", update)
+	return
+}
@@ -0,0 +1,92 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestUserGroupMembersFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	mfa := zapi.MFAEnabled
+	hostGroupIDs := srv.Add("hostgroup", map[string]interface{}{"name": "Linux servers"})
+	groups := zapi.UserGroups{{
+		Name:       "Operators",
+		MFAStatus:  &mfa,
+		MFAID:      "1",
+		TagFilters: zapi.UserGroupTagFilters{{GroupID: hostGroupIDs[0], Tag: "service", Value: "db"}},
+	}}
+	if err := api.UserGroupsCreate(groups); err != nil {
+		t.Fatal(err)
+	}
+
+	users := zapi.Users{
+		{Username: "alice", Password: "Secret-123", RoleID: "1", Groups: []zapi.UserGroupID{{UserGroupID: groups[0].UserGroupID}}},
+		{Username: "bob", Password: "Secret-123", RoleID: "1", Lang: "de_DE", Timezone: "Europe/Berlin", RowsPerPage: 100, AutoLogin: zapi.AutoLoginEnabled},
+		{Username: "carol", Password: "Secret-123", RoleID: "1"},
+	}
+	if err := api.UsersCreate(users); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.UserGroupsUpdate(zapi.UserGroups{{UserGroupID: groups[0].UserGroupID, Name: "Operators", MFAStatus: &mfa, Users: []zapi.UserID{{UserID: users[0].UserID}}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	bob, err := api.UserGetByID(users[1].UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bob.Lang != "de_DE" || bob.Timezone != "Europe/Berlin" || bob.RowsPerPage != 100 || bob.AutoLogin != zapi.AutoLoginEnabled {
+		t.Errorf("unexpected user %#v", bob)
+	}
+
+	if err = api.UserGroupsAddUsers([]string{groups[0].UserGroupID}, []string{users[1].UserID, users[2].UserID}); err != nil {
+		t.Fatal(err)
+	}
+	if err = api.UserGroupsRemoveUsers([]string{groups[0].UserGroupID}, []string{users[0].UserID}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := api.UserGroupsGet(zapi.Params{"usrgrpids": groups[0].UserGroupID, "selectUsers": "extend", "selectTagFilters": "extend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Name != "Operators" || got[0].MFAStatus == nil || *got[0].MFAStatus != zapi.MFAEnabled {
+		t.Fatalf("unexpected user groups %#v", got)
+	}
+	members := map[string]bool{}
+	for _, u := range got[0].Users {
+		members[u.UserID] = true
+	}
+	if len(members) != 2 || !members[users[1].UserID] || !members[users[2].UserID] {
+		t.Errorf("unexpected members %#v", got[0].Users)
+	}
+	if len(got[0].TagFilters) != 1 || got[0].TagFilters[0].Tag != "service" {
+		t.Errorf("unexpected tag filters %#v", got[0].TagFilters)
+	}
+
+	if err = api.UserGroupsAddUsers([]string{"999999"}, []string{users[0].UserID}); err == nil {
+		t.Error("expected error for missing user group")
+	}
+}
+
+func TestUserGroupMFAStatusJSON(t *testing.T) {
+	b, err := json.Marshal(zapi.UserGroup{Name: "Operators"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "mfa_status") {
+		t.Errorf("unset MFA status sent in %s", b)
+	}
+
+	disabled := zapi.MFADisabled
+	if b, err = json.Marshal(zapi.UserGroup{Name: "Operators", MFAStatus: &disabled}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"mfa_status":"0"`) {
+		t.Errorf("MFA status not sent in %s", b)
+	}
+}
@@ -0,0 +1,281 @@
+package zabbix
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type (
+	// IdPType identity provider type of a user directory
+	// see "idp_type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/object
+	IdPType int
+
+	// ProvisionStatusType whether just-in-time user provisioning is enabled
+	// see "provision_status" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/object
+	ProvisionStatusType int
+)
+
+const (
+	// IdPLDAP LDAP
+	IdPLDAP IdPType = 1
+	// IdPSAML SAML
+	IdPSAML IdPType = 2
+)
+
+const (
+	// ProvisionDisabled provisioning is disabled
+	ProvisionDisabled ProvisionStatusType = 0
+	// ProvisionEnabled provisioning is enabled
+	ProvisionEnabled ProvisionStatusType = 1
+)
+
+// ProvisionMedia maps an identity provider attribute to a media of provisioned users
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/object#media-type-mappings
+type ProvisionMedia struct {
+	MediaID     string              `json:"userdirectory_mediaid,omitempty"`
+	Name        string              `json:"name"`
+	MediaTypeID string              `json:"mediatypeid"`
+	Attribute   string              `json:"attribute"`
+	Active      UserMediaStatusType `json:"active,string"`
+	// Severity zero leaves the server default of all severities
+	Severity MediaSeverity `json:"severity,omitempty,string"`
+	// Period when the media is active, e.g. MediaPeriodAlways
+	Period string `json:"period,omitempty"`
+}
+
+// ProvisionMedias is an array of ProvisionMedia
+type ProvisionMedias []ProvisionMedia
+
+// ProvisionGroup maps an identity provider group to the role and user groups of provisioned users
+// Name may contain "*" wildcards.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/object#provisioning-groups-mappings
+type ProvisionGroup struct {
+	Name       string       `json:"name"`
+	RoleID     string       `json:"roleid"`
+	UserGroups usergroupids `json:"user_groups"`
+}
+
+// ProvisionGroups is an array of ProvisionGroup
+type ProvisionGroups []ProvisionGroup
+
+// UserDirectory represent Zabbix user directory object
+// Only the fields of IdPType are sent. Write only fields (BindPassword, SPPrivateKey)
+// are never returned and left unchanged when empty.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/object
+type UserDirectory struct {
+	UserDirectoryID string              `json:"userdirectoryid,omitempty"`
+	Name            string              `json:"name"`
+	IdPType         IdPType             `json:"idp_type,string"`
+	Description     string              `json:"description"`
+	ProvisionStatus ProvisionStatusType `json:"provision_status,string"`
+	// ProvisionMedia is only returned when requested with "selectProvisionMedia"
+	ProvisionMedia ProvisionMedias `json:"provision_media,omitempty"`
+	// ProvisionGroups is only returned when requested with "selectProvisionGroups"
+	ProvisionGroups ProvisionGroups `json:"provision_groups,omitempty"`
+
+	// Attributes read from the identity provider for provisioning
+	GroupName    string `json:"group_name"`
+	UserUsername string `json:"user_username"`
+	UserLastname string `json:"user_lastname"`
+
+	// LDAP
+	Host            string `json:"host"`
+	Port            int    `json:"port,string"`
+	BaseDN          string `json:"base_dn"`
+	SearchAttribute string `json:"search_attribute"`
+	BindDN          string `json:"bind_dn"`
+	BindPassword    string `json:"bind_password,omitempty"`
+	SearchFilter    string `json:"search_filter"`
+	StartTLS        int    `json:"start_tls,string"`
+	GroupBaseDN     string `json:"group_basedn"`
+	GroupMember     string `json:"group_member"`
+	UserRefAttr     string `json:"user_ref_attr"`
+	GroupFilter     string `json:"group_filter"`
+	GroupMembership string `json:"group_membership"`
+
+	// SAML
+	IdPEntityID         string `json:"idp_entityid"`
+	SSOURL              string `json:"sso_url"`
+	SLOURL              string `json:"slo_url"`
+	UsernameAttribute   string `json:"username_attribute"`
+	SPEntityID          string `json:"sp_entityid"`
+	NameIDFormat        string `json:"nameid_format"`
+	SignMessages        int    `json:"sign_messages,string"`
+	SignAssertions      int    `json:"sign_assertions,string"`
+	SignAuthnRequests   int    `json:"sign_authn_requests,string"`
+	SignLogoutRequests  int    `json:"sign_logout_requests,string"`
+	SignLogoutResponses int    `json:"sign_logout_responses,string"`
+	EncryptNameID       int    `json:"encrypt_nameid,string"`
+	EncryptAssertions   int    `json:"encrypt_assertions,string"`
+	SCIMStatus          int    `json:"scim_status,string"`
+	// certificates and key in PEM format
+	IdPCertificate string `json:"idp_certificate"`
+	SPCertificate  string `json:"sp_certificate"`
+	SPPrivateKey   string `json:"sp_private_key,omitempty"`
+}
+
+// UserDirectories is an array of UserDirectory
+type UserDirectories []UserDirectory
+
+// idpFields fields only accepted for directories of one identity provider type.
+var idpFields = map[IdPType][]string{
+	IdPLDAP: {"host", "port", "base_dn", "search_attribute", "bind_dn", "bind_password", "search_filter",
+		"start_tls", "group_basedn", "group_member", "user_ref_attr", "group_filter", "group_membership"},
+	IdPSAML: {"idp_entityid", "sso_url", "slo_url", "username_attribute", "sp_entityid", "nameid_format",
+		"sign_messages", "sign_assertions", "sign_authn_requests", "sign_logout_requests", "sign_logout_responses",
+		"encrypt_nameid", "encrypt_assertions", "scim_status", "idp_certificate", "sp_certificate", "sp_private_key"},
+}
+
+// MarshalJSON leaves out the fields of other identity provider types, which Zabbix rejects.
+func (d UserDirectory) MarshalJSON() ([]byte, error) {
+	fields, err := d.fields()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// fields returns the encoded fields of d accepted for its identity provider type.
+func (d UserDirectory) fields() (fields map[string]json.RawMessage, err error) {
+	type plain UserDirectory
+	b, err := json.Marshal(plain(d))
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		return
+	}
+	for t, names := range idpFields {
+		if t == d.IdPType {
+			continue
+		}
+		for _, name := range names {
+			delete(fields, name)
+		}
+	}
+	return
+}
+
+// UserDirectoriesGet Wrapper for userdirectory.get
+// Selects provisioning media and groups unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/get
+func (api *API) UserDirectoriesGet(params Params) (res UserDirectories, err error) {
+	return api.UserDirectoriesGetContext(context.Background(), params)
+}
+
+// UserDirectoriesGetContext is like UserDirectoriesGet but uses ctx for the underlying API calls.
+func (api *API) UserDirectoriesGetContext(ctx context.Context, params Params) (res UserDirectories, err error) {
+	for _, key := range []string{"output", "selectProvisionMedia", "selectProvisionGroups"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "userdirectory.get", params, &res)
+	return
+}
+
+// UserDirectoryGetByID Gets user directory by ID only if there is exactly 1 matching directory.
+func (api *API) UserDirectoryGetByID(id string) (res *UserDirectory, err error) {
+	return api.UserDirectoryGetByIDContext(context.Background(), id)
+}
+
+// UserDirectoryGetByIDContext is like UserDirectoryGetByID but uses ctx for the underlying API calls.
+func (api *API) UserDirectoryGetByIDContext(ctx context.Context, id string) (res *UserDirectory, err error) {
+	directories, err := api.UserDirectoriesGetContext(ctx, Params{"userdirectoryids": id})
+	if err != nil {
+		return
+	}
+
+	if len(directories) == 1 {
+		res = &directories[0]
+	} else {
+		e := ExpectedOneResult(len(directories))
+		err = &e
+	}
+	return
+}
+
+// UserDirectoriesCreate Wrapper for userdirectory.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/create
+func (api *API) UserDirectoriesCreate(directories UserDirectories) (err error) {
+	return api.UserDirectoriesCreateContext(context.Background(), directories)
+}
+
+// UserDirectoriesCreateContext is like UserDirectoriesCreate but uses ctx for the underlying API calls.
+func (api *API) UserDirectoriesCreateContext(ctx context.Context, directories UserDirectories) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "userdirectory.create", directories)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	userdirectoryids := result["userdirectoryids"].([]interface{})
+	for i, id := range userdirectoryids {
+		directories[i].UserDirectoryID = id.(string)
+	}
+	return
+}
+
+// UserDirectoriesUpdate Wrapper for userdirectory.update
+// IdPType is not sent, the type of a directory can not be changed.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/update
+func (api *API) UserDirectoriesUpdate(directories UserDirectories) (err error) {
+	return api.UserDirectoriesUpdateContext(context.Background(), directories)
+}
+
+// UserDirectoriesUpdateContext is like UserDirectoriesUpdate but uses ctx for the underlying API calls.
+func (api *API) UserDirectoriesUpdateContext(ctx context.Context, directories UserDirectories) (err error) {
+	update := make([]map[string]json.RawMessage, len(directories))
+	for i, d := range directories {
+		if update[i], err = d.fields(); err != nil {
+			return
+		}
+		delete(update[i], "idp_type")
+	}
+	_, err = api.CallWithErrorContext(ctx, "userdirectory.update", update)
+	return
+}
+
+// UserDirectoriesDelete Wrapper for userdirectory.delete
+// Cleans UserDirectoryID in all directories elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/delete
+func (api *API) UserDirectoriesDelete(directories UserDirectories) (err error) {
+	return api.UserDirectoriesDeleteContext(context.Background(), directories)
+}
+
+// UserDirectoriesDeleteContext is like UserDirectoriesDelete but uses ctx for the underlying API calls.
+func (api *API) UserDirectoriesDeleteContext(ctx context.Context, directories UserDirectories) (err error) {
+	ids := make([]string, len(directories))
+	for i, directory := range directories {
+		ids[i] = directory.UserDirectoryID
+	}
+
+	err = api.UserDirectoriesDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range directories {
+			directories[i].UserDirectoryID = ""
+		}
+	}
+	return
+}
+
+// UserDirectoriesDeleteByIds Wrapper for userdirectory.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/userdirectory/delete
+func (api *API) UserDirectoriesDeleteByIds(ids []string) (err error) {
+	return api.UserDirectoriesDeleteByIdsContext(context.Background(), ids)
+}
+
+// UserDirectoriesDeleteByIdsContext is like UserDirectoriesDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) UserDirectoriesDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "userdirectory.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	userdirectoryids := result["userdirectoryids"].([]interface{})
+	if len(ids) != len(userdirectoryids) {
+		err = &ExpectedMore{len(ids), len(userdirectoryids)}
+	}
+	return
+}
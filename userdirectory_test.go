@@ -0,0 +1,121 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestUserDirectoriesGet(t *testing.T) {
+	api := getAPI(t)
+
+	if _, err := api.UserDirectoriesGet(zapi.Params{}); err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+	if _, err := api.AuthenticationGet(); err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+}
+
+func TestUserDirectoriesFake(t *testing.T) {
+	api, srv := getFakeAPI(t)
+
+	groups := zapi.UserGroups{{Name: "LDAP users"}}
+	if err := api.UserGroupsCreate(groups); err != nil {
+		t.Fatal(err)
+	}
+
+	directories := zapi.UserDirectories{{
+		Name:            "Corporate LDAP",
+		IdPType:         zapi.IdPLDAP,
+		Host:            "ldaps://ldap.example.com",
+		Port:            636,
+		BaseDN:          "ou=people,dc=example,dc=com",
+		SearchAttribute: "uid",
+		BindDN:          "cn=zabbix,dc=example,dc=com",
+		BindPassword:    "secret",
+		ProvisionStatus: zapi.ProvisionEnabled,
+		GroupName:       "cn",
+		ProvisionGroups: zapi.ProvisionGroups{{Name: "monitoring-*", RoleID: "1", UserGroups: []zapi.UserGroupID{{UserGroupID: groups[0].UserGroupID}}}},
+		ProvisionMedia:  zapi.ProvisionMedias{{Name: "Email", MediaTypeID: "1", Attribute: "mail"}},
+	}}
+	if err := api.UserDirectoriesCreate(directories); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := srv.Objects("userdirectory")[0]["sso_url"]; ok {
+		t.Error("SAML fields sent for an LDAP directory")
+	}
+
+	got, err := api.UserDirectoryGetByID(directories[0].UserDirectoryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Port != 636 || got.IdPType != zapi.IdPLDAP || len(got.ProvisionGroups) != 1 || len(got.ProvisionMedia) != 1 {
+		t.Errorf("unexpected directory %#v", got)
+	}
+	if got.ProvisionGroups[0].UserGroups[0].UserGroupID != groups[0].UserGroupID {
+		t.Errorf("unexpected provisioning groups %#v", got.ProvisionGroups)
+	}
+
+	got.BindPassword = ""
+	got.Description = "Managed by automation"
+	if err = api.UserDirectoriesUpdate(zapi.UserDirectories{*got}); err != nil {
+		t.Fatal(err)
+	}
+	if obj := srv.Objects("userdirectory")[0]; obj["bind_password"] != "secret" || obj["description"] != "Managed by automation" {
+		t.Errorf("unexpected stored directory %v", obj)
+	}
+
+	groups[0].UserDirectoryID = directories[0].UserDirectoryID
+	if err = api.UserGroupsUpdate(groups); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := api.AuthenticationGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings.PasswordMinLength != 8 || settings.PasswordRules != zapi.PasswordNotSimple {
+		t.Errorf("unexpected settings %#v", settings)
+	}
+	settings.AuthenticationType = zapi.AuthenticationLDAP
+	settings.LDAPAuthEnabled = 1
+	settings.LDAPUserDirectory = directories[0].UserDirectoryID
+	settings.PasswordRules = zapi.PasswordMixedCase | zapi.PasswordDigits
+	changed, err := api.AuthenticationUpdate(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(changed, []string{"authentication_type", "ldap_auth_enabled", "ldap_userdirectoryid", "passwd_check_rules"}) {
+		t.Errorf("unexpected changed fields %v", changed)
+	}
+	stored := srv.Singleton("authentication")
+	if stored["ldap_userdirectoryid"] != directories[0].UserDirectoryID || stored["passwd_check_rules"] != "3" || stored["authentication_type"] != "1" {
+		t.Errorf("unexpected stored settings %v", stored)
+	}
+
+	if err = api.UserDirectoriesDelete(directories); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUserDirectoryMarshal(t *testing.T) {
+	b, err := json.Marshal(zapi.UserDirectory{Name: "SSO", IdPType: zapi.IdPSAML, SSOURL: "https://idp.example.com/sso"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]interface{}
+	if err = json.Unmarshal(b, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["sso_url"] != "https://idp.example.com/sso" || fields["idp_type"] != "2" {
+		t.Errorf("unexpected fields %v", fields)
+	}
+	if _, ok := fields["host"]; ok {
+		t.Errorf("LDAP fields sent for a SAML directory: %v", fields)
+	}
+}
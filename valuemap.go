@@ -0,0 +1,268 @@
+package zabbix
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ValueMappingType how a mapping matches values
+// see "type" in: https://www.zabbix.com/documentation/7.0/en/manual/api/reference/valuemap/object#value-mappings
+type ValueMappingType int
+
+const (
+	// ValueMappingEqual value is equal
+	ValueMappingEqual ValueMappingType = 0
+	// ValueMappingGreaterOrEqual value is greater than or equal
+	ValueMappingGreaterOrEqual ValueMappingType = 1
+	// ValueMappingLessOrEqual value is less than or equal
+	ValueMappingLessOrEqual ValueMappingType = 2
+	// ValueMappingRange value is in one of comma separated ranges, like "1-10,20,-5--1"
+	ValueMappingRange ValueMappingType = 3
+	// ValueMappingRegexp value matches a regular expression
+	ValueMappingRegexp ValueMappingType = 4
+	// ValueMappingDefault any value not matched by other mappings
+	ValueMappingDefault ValueMappingType = 5
+)
+
+// ValueMapping maps values to a new value
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/valuemap/object#value-mappings
+type ValueMapping struct {
+	Type ValueMappingType `json:"type,string"`
+	// Value is empty for ValueMappingDefault
+	Value    string `json:"value"`
+	NewValue string `json:"newvalue"`
+}
+
+// ValueMappings is an array of ValueMapping
+type ValueMappings []ValueMapping
+
+// ValueMap represent Zabbix value map object
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/valuemap/object
+type ValueMap struct {
+	ValueMapID string `json:"valuemapid,omitempty"`
+	// HostID of the host or template the value map belongs to, can not be changed
+	HostID   string        `json:"hostid,omitempty"`
+	Name     string        `json:"name"`
+	Mappings ValueMappings `json:"mappings"`
+	UUID     string        `json:"uuid,omitempty"`
+}
+
+// ValueMaps is an array of ValueMap
+type ValueMaps []ValueMap
+
+// Resolve returns the new value of the mapping value matches and whether one matched.
+// As in the frontend, equal mappings are checked first, then the numeric ones and
+// finally the default one, mappings of the same stage in order. Values parsed as
+// numbers are compared numerically and other ones as strings, so that only they
+// are checked against regular expressions.
+func (m ValueMap) Resolve(value string) (res string, ok bool) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	numeric := err == nil
+
+	for _, mapping := range m.Mappings {
+		switch mapping.Type {
+		case ValueMappingEqual:
+			if numeric {
+				v, err := strconv.ParseFloat(strings.TrimSpace(mapping.Value), 64)
+				ok = err == nil && v == number
+			} else {
+				ok = mapping.Value == value
+			}
+		case ValueMappingRegexp:
+			if !numeric {
+				re, err := regexp.Compile(mapping.Value)
+				ok = err == nil && re.MatchString(value)
+			}
+		}
+		if ok {
+			return mapping.NewValue, true
+		}
+	}
+
+	if numeric {
+		for _, mapping := range m.Mappings {
+			switch mapping.Type {
+			case ValueMappingGreaterOrEqual:
+				v, err := strconv.ParseFloat(strings.TrimSpace(mapping.Value), 64)
+				ok = err == nil && number >= v
+			case ValueMappingLessOrEqual:
+				v, err := strconv.ParseFloat(strings.TrimSpace(mapping.Value), 64)
+				ok = err == nil && number <= v
+			case ValueMappingRange:
+				ok = inValueRanges(number, mapping.Value)
+			}
+			if ok {
+				return mapping.NewValue, true
+			}
+		}
+	}
+
+	for _, mapping := range m.Mappings {
+		if mapping.Type == ValueMappingDefault {
+			return mapping.NewValue, true
+		}
+	}
+	return
+}
+
+// inValueRanges reports whether v is in one of the comma separated ranges,
+// each a number or two numbers separated by "-", like "1-10,20,-5--1".
+func inValueRanges(v float64, ranges string) bool {
+	for _, r := range strings.Split(ranges, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		low, high := r, r
+		// skip the sign of the lower bound
+		if i := strings.Index(r[1:], "-"); i >= 0 {
+			low, high = r[:i+1], r[i+2:]
+		}
+		l, err := strconv.ParseFloat(strings.TrimSpace(low), 64)
+		if err != nil {
+			continue
+		}
+		h, err := strconv.ParseFloat(strings.TrimSpace(high), 64)
+		if err != nil {
+			continue
+		}
+		if l <= v && v <= h {
+			return true
+		}
+	}
+	return false
+}
+
+// ValueMapsGet Wrapper for valuemap.get
+// Selects mappings unless params request otherwise.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/valuemap/get
+func (api *API) ValueMapsGet(params Params) (res ValueMaps, err error) {
+	return api.ValueMapsGetContext(context.Background(), params)
+}
+
+// ValueMapsGetContext is like ValueMapsGet but uses ctx for the underlying API calls.
+func (api *API) ValueMapsGetContext(ctx context.Context, params Params) (res ValueMaps, err error) {
+	for _, key := range []string{"output", "selectMappings"} {
+		if _, present := params[key]; !present {
+			params[key] = "extend"
+		}
+	}
+	err = api.CallWithErrorParseContext(ctx, "valuemap.get", params, &res)
+	return
+}
+
+// ValueMapsGetByHostIds Gets value maps of the given hosts or templates.
+func (api *API) ValueMapsGetByHostIds(ids []string) (res ValueMaps, err error) {
+	return api.ValueMapsGetByHostIdsContext(context.Background(), ids)
+}
+
+// ValueMapsGetByHostIdsContext is like ValueMapsGetByHostIds but uses ctx for the underlying API calls.
+func (api *API) ValueMapsGetByHostIdsContext(ctx context.Context, ids []string) (res ValueMaps, err error) {
+	return api.ValueMapsGetContext(ctx, Params{"hostids": ids})
+}
+
+// ValueMapGetByID Gets value map by ID only if there is exactly 1 matching value map.
+func (api *API) ValueMapGetByID(id string) (res *ValueMap, err error) {
+	return api.ValueMapGetByIDContext(context.Background(), id)
+}
+
+// ValueMapGetByIDContext is like ValueMapGetByID but uses ctx for the underlying API calls.
+func (api *API) ValueMapGetByIDContext(ctx context.Context, id string) (res *ValueMap, err error) {
+	valuemaps, err := api.ValueMapsGetContext(ctx, Params{"valuemapids": id})
+	if err != nil {
+		return
+	}
+
+	if len(valuemaps) == 1 {
+		res = &valuemaps[0]
+	} else {
+		e := ExpectedOneResult(len(valuemaps))
+		err = &e
+	}
+	return
+}
+
+// ValueMapsCreate Wrapper for valuemap.create
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/valuemap/create
+func (api *API) ValueMapsCreate(valuemaps ValueMaps) (err error) {
+	return api.ValueMapsCreateContext(context.Background(), valuemaps)
+}
+
+// ValueMapsCreateContext is like ValueMapsCreate but uses ctx for the underlying API calls.
+func (api *API) ValueMapsCreateContext(ctx context.Context, valuemaps ValueMaps) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "valuemap.create", valuemaps)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	valuemapids := result["valuemapids"].([]interface{})
+	for i, id := range valuemapids {
+		valuemaps[i].ValueMapID = id.(string)
+	}
+	return
+}
+
+// ValueMapsUpdate Wrapper for valuemap.update
+// HostID is not sent, as Zabbix rejects it on update.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/valuemap/update
+func (api *API) ValueMapsUpdate(valuemaps ValueMaps) (err error) {
+	return api.ValueMapsUpdateContext(context.Background(), valuemaps)
+}
+
+// ValueMapsUpdateContext is like ValueMapsUpdate but uses ctx for the underlying API calls.
+func (api *API) ValueMapsUpdateContext(ctx context.Context, valuemaps ValueMaps) (err error) {
+	update := make(ValueMaps, len(valuemaps))
+	for i, valuemap := range valuemaps {
+		valuemap.HostID = ""
+		update[i] = valuemap
+	}
+	_, err = api.CallWithErrorContext(ctx, "valuemap.update", update)
+	return
+}
+
+// ValueMapsDelete Wrapper for valuemap.delete
+// Cleans ValueMapID in all valuemaps elements if call succeeds.
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/valuemap/delete
+func (api *API) ValueMapsDelete(valuemaps ValueMaps) (err error) {
+	return api.ValueMapsDeleteContext(context.Background(), valuemaps)
+}
+
+// ValueMapsDeleteContext is like ValueMapsDelete but uses ctx for the underlying API calls.
+func (api *API) ValueMapsDeleteContext(ctx context.Context, valuemaps ValueMaps) (err error) {
+	ids := make([]string, len(valuemaps))
+	for i, valuemap := range valuemaps {
+		ids[i] = valuemap.ValueMapID
+	}
+
+	err = api.ValueMapsDeleteByIdsContext(ctx, ids)
+	if err == nil {
+		for i := range valuemaps {
+			valuemaps[i].ValueMapID = ""
+		}
+	}
+	return
+}
+
+// ValueMapsDeleteByIds Wrapper for valuemap.delete
+// https://www.zabbix.com/documentation/7.0/en/manual/api/reference/valuemap/delete
+func (api *API) ValueMapsDeleteByIds(ids []string) (err error) {
+	return api.ValueMapsDeleteByIdsContext(context.Background(), ids)
+}
+
+// ValueMapsDeleteByIdsContext is like ValueMapsDeleteByIds but uses ctx for the underlying API calls.
+func (api *API) ValueMapsDeleteByIdsContext(ctx context.Context, ids []string) (err error) {
+	response, err := api.CallWithErrorContext(ctx, "valuemap.delete", ids)
+	if err != nil {
+		return
+	}
+
+	result := response.Result.(map[string]interface{})
+	valuemapids := result["valuemapids"].([]interface{})
+	if len(ids) != len(valuemapids) {
+		err = &ExpectedMore{len(ids), len(valuemapids)}
+	}
+	return
+}
@@ -0,0 +1,139 @@
+package zabbix_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+)
+
+func TestValueMapsGet(t *testing.T) {
+	api := getAPI(t)
+
+	valuemaps, err := api.ValueMapsGet(zapi.Params{})
+	if err != nil {
+		maybeSkipRestricted(t, err)
+		t.Fatal(err)
+	}
+	if len(valuemaps) == 0 {
+		return
+	}
+
+	valuemap, err := api.ValueMapGetByID(valuemaps[0].ValueMapID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(valuemap.Mappings) == 0 {
+		t.Errorf("mappings not selected for value map %q", valuemap.Name)
+	}
+}
+
+func TestValueMapResolve(t *testing.T) {
+	valuemap := zapi.ValueMap{Mappings: zapi.ValueMappings{
+		{Type: zapi.ValueMappingGreaterOrEqual, Value: "100", NewValue: "High"},
+		{Type: zapi.ValueMappingEqual, Value: "1", NewValue: "Up"},
+		{Type: zapi.ValueMappingEqual, Value: "200", NewValue: "OK"},
+		{Type: zapi.ValueMappingRange, Value: "-10--1, 2-5,7", NewValue: "Degraded"},
+		{Type: zapi.ValueMappingLessOrEqual, Value: "-20", NewValue: "Low"},
+		{Type: zapi.ValueMappingEqual, Value: "down", NewValue: "Down"},
+		{Type: zapi.ValueMappingRegexp, Value: "^err", NewValue: "Error"},
+		{Type: zapi.ValueMappingDefault, NewValue: "Unknown"},
+	}}
+
+	for value, want := range map[string]string{
+		"1":       "Up",
+		"1.0":     "Up",
+		"200":     "OK", // equal mappings are checked before earlier numeric ones
+		"150":     "High",
+		"3":       "Degraded",
+		"7":       "Degraded",
+		"-5":      "Degraded",
+		"-25":     "Low",
+		"6":       "Unknown",
+		"down":    "Down",
+		"error 5": "Error",
+		"up":      "Unknown",
+	} {
+		if got, ok := valuemap.Resolve(value); !ok || got != want {
+			t.Errorf("Resolve(%q) = %q, %v; want %q", value, got, ok, want)
+		}
+	}
+
+	valuemap.Mappings = valuemap.Mappings[:len(valuemap.Mappings)-1]
+	if got, ok := valuemap.Resolve("6"); ok {
+		t.Errorf("Resolve without default mapping = %q, want no match", got)
+	}
+}
+
+func TestItemValueMap(t *testing.T) {
+	var items zapi.Items
+	data := `[{"itemid":"1","valuemapid":"5","valuemap":{"valuemapid":"5","name":"State","mappings":[{"type":"0","value":"1","newvalue":"Up"}]}},
+		{"itemid":"2","valuemapid":"0","valuemap":[]}]`
+	if err := json.Unmarshal([]byte(data), &items); err != nil {
+		t.Fatal(err)
+	}
+	if items[0].ValueMapID != "5" || items[0].ValueMap == nil || items[0].ValueMap.Name != "State" {
+		t.Fatalf("unexpected item %#v", items[0])
+	}
+	if state, ok := items[0].ValueMap.Resolve("1"); !ok || state != "Up" {
+		t.Errorf("unexpected resolved value %q", state)
+	}
+	if items[1].ValueMap != nil {
+		t.Errorf("unexpected value map %#v", items[1].ValueMap)
+	}
+
+	// the selected value map is not sent back on update
+	b, err := json.Marshal(items[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), `"valuemap"`) {
+		t.Errorf("value map sent in %s", b)
+	}
+}
+
+func TestValueMapsFake(t *testing.T) {
+	api, _ := getFakeAPI(t)
+
+	valuemaps := zapi.ValueMaps{{
+		HostID: "10001",
+		Name:   "Service state",
+		Mappings: zapi.ValueMappings{
+			{Type: zapi.ValueMappingEqual, Value: "0", NewValue: "Down"},
+			{Type: zapi.ValueMappingEqual, Value: "1", NewValue: "Up"},
+		},
+	}}
+	if err := api.ValueMapsCreate(valuemaps); err != nil {
+		t.Fatal(err)
+	}
+
+	valuemaps[0].Mappings = append(valuemaps[0].Mappings, zapi.ValueMapping{Type: zapi.ValueMappingDefault, NewValue: "Unknown"})
+	if err := api.ValueMapsUpdate(valuemaps); err != nil {
+		t.Fatal(err)
+	}
+	if valuemaps[0].HostID != "10001" {
+		t.Error("update changed the caller's host id")
+	}
+
+	got, err := api.ValueMapsGetByHostIds([]string{"10001"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ValueMapID != valuemaps[0].ValueMapID || len(got[0].Mappings) != 3 {
+		t.Fatalf("unexpected value maps %#v", got)
+	}
+	if state, ok := got[0].Resolve("1"); !ok || state != "Up" {
+		t.Errorf("unexpected resolved value %q", state)
+	}
+	if others, err := api.ValueMapsGetByHostIds([]string{"10002"}); err != nil || len(others) != 0 {
+		t.Errorf("unexpected value maps of another host %#v, %v", others, err)
+	}
+
+	if err := api.ValueMapsDelete(valuemaps); err != nil {
+		t.Fatal(err)
+	}
+	if valuemaps[0].ValueMapID != "" {
+		t.Error("value map id was not cleared after delete")
+	}
+}
@@ -0,0 +1,1108 @@
+/*
+Package zabbixtest provides an in-memory fake of the Zabbix JSON-RPC API
+backed by httptest.Server, so code using this library can be tested without
+a live Zabbix instance.
+
+	srv := zabbixtest.NewServer()
+	defer srv.Close()
+
+	api, err := zabbix.NewAPI(zabbix.Config{Url: srv.URL})
+	...
+	_, err = api.Login(zabbixtest.DefaultUser, zabbixtest.DefaultPassword)
+
+The fake implements apiinfo.version, user.login, user.logout,
+user.checkAuthentication, token.generate, get/create/update/delete for
+the resources listed in DefaultResources and get/update for the settings
+objects listed in DefaultSingletons. Gets accept "<id>_from" on every
+resource to page by ID, as the iterators do. Like a real server, every scalar is
+returned string-encoded and IDs are allocated from a shared sequence.
+*/
+package zabbixtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Default credentials and version of a new Server.
+const (
+	DefaultUser     = "Admin"
+	DefaultPassword = "zabbix"
+	DefaultVersion  = "7.0.0"
+)
+
+// JSON-RPC error codes used by Zabbix.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeApplication    = -32500
+)
+
+// Error a JSON-RPC error returned by a handler.
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d (%s): %s", e.Code, e.Message, e.Data)
+}
+
+// InvalidParams builds a CodeInvalidParams error.
+func InvalidParams(format string, v ...interface{}) *Error {
+	return &Error{Code: CodeInvalidParams, Message: "Invalid params.", Data: fmt.Sprintf(format, v...)}
+}
+
+// ApplicationError builds a CodeApplication error.
+func ApplicationError(format string, v ...interface{}) *Error {
+	return &Error{Code: CodeApplication, Message: "Application error.", Data: fmt.Sprintf(format, v...)}
+}
+
+// errSessionTerminated is returned for expired sessions and unknown tokens.
+func errSessionTerminated() *Error {
+	return InvalidParams("Session terminated, re-login, please.")
+}
+
+// errNoObject is returned for references to missing objects, like a real server does.
+func errNoObject() *Error {
+	return ApplicationError("No permissions to referred object or it does not exist!")
+}
+
+// HandlerFunc handles one API method. The returned value is marshaled as the result.
+// Returning an *Error sends it as the JSON-RPC error.
+type HandlerFunc func(params json.RawMessage) (interface{}, error)
+
+// Resource describes an API object served with generic CRUD methods.
+type Resource struct {
+	// Name API object name, like "host"
+	Name string
+	// IDField primary key of the object, like "hostid"
+	IDField string
+	// IDsParam get parameter filtering by primary key; defaults to IDField + "s"
+	IDsParam string
+	// CreateKey key of the ID list in create and update results; defaults to IDField + "s"
+	CreateKey string
+	// DeleteKey key of the ID list in delete results; defaults to CreateKey
+	DeleteKey string
+	// UniqueField field that must be unique among objects, if any
+	UniqueField string
+	// DuplicateFormat error message for a duplicate UniqueField, with %s replaced by its value
+	DuplicateFormat string
+	// ListFields nested object lists returned by output without a select* parameter
+	ListFields []string
+	// References maps get parameters like "discoveryids" to the field they filter on,
+	// when it is not the parameter name without its trailing "s"
+	References map[string]string
+}
+
+func (r Resource) listField(name string) bool {
+	for _, f := range r.ListFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Resource) idsParam() string {
+	if r.IDsParam != "" {
+		return r.IDsParam
+	}
+	return r.IDField + "s"
+}
+
+func (r Resource) createKey() string {
+	if r.CreateKey != "" {
+		return r.CreateKey
+	}
+	return r.IDField + "s"
+}
+
+func (r Resource) deleteKey() string {
+	if r.DeleteKey != "" {
+		return r.DeleteKey
+	}
+	return r.createKey()
+}
+
+// DefaultResources resources registered on every new Server.
+var DefaultResources = []Resource{
+	{Name: "host", IDField: "hostid", UniqueField: "host", DuplicateFormat: `Host with the same name "%s" already exists.`},
+	{Name: "hostgroup", IDField: "groupid", UniqueField: "name", DuplicateFormat: `Host group "%s" already exists.`},
+	{Name: "templategroup", IDField: "groupid", UniqueField: "name", DuplicateFormat: `Template group "%s" already exists.`},
+	{Name: "template", IDField: "templateid", UniqueField: "host", DuplicateFormat: `Template with the same name "%s" already exists.`},
+	{Name: "hostinterface", IDField: "interfaceid"},
+	{Name: "item", IDField: "itemid"},
+	{Name: "itemprototype", IDField: "itemid", DeleteKey: "prototypeids"},
+	{Name: "discoveryrule", IDField: "itemid", DeleteKey: "ruleids"},
+	{Name: "trigger", IDField: "triggerid"},
+	{Name: "triggerprototype", IDField: "triggerid"},
+	{Name: "graph", IDField: "graphid"},
+	{Name: "graphprototype", IDField: "graphid"},
+	{Name: "usermacro", IDField: "hostmacroid"},
+	{Name: "proxy", IDField: "proxyid", UniqueField: "name", DuplicateFormat: `Proxy "%s" already exists.`},
+	{Name: "user", IDField: "userid", UniqueField: "username", DuplicateFormat: `User with username "%s" already exists.`},
+	{Name: "usergroup", IDField: "usrgrpid", UniqueField: "name", DuplicateFormat: `User group "%s" already exists.`},
+	{Name: "service", IDField: "serviceid"},
+	{Name: "sla", IDField: "slaid", UniqueField: "name", DuplicateFormat: `SLA "%s" already exists.`},
+	{Name: "report", IDField: "reportid", UniqueField: "name", DuplicateFormat: `Report "%s" already exists.`},
+	{Name: "action", IDField: "actionid", UniqueField: "name", DuplicateFormat: `Action "%s" already exists.`},
+	{Name: "mediatype", IDField: "mediatypeid", UniqueField: "name", DuplicateFormat: `Media type "%s" already exists.`, ListFields: []string{"parameters"}},
+	{Name: "maintenance", IDField: "maintenanceid", UniqueField: "name", DuplicateFormat: `Maintenance "%s" already exists.`},
+	{Name: "httptest", IDField: "httptestid", UniqueField: "name", DuplicateFormat: `Web scenario "%s" already exists.`, ListFields: []string{"headers", "variables"}},
+	{Name: "hostprototype", IDField: "hostid", UniqueField: "host", DuplicateFormat: `Host prototype with host name "%s" already exists.`, References: map[string]string{"discoveryids": "ruleid"}},
+	{Name: "drule", IDField: "druleid", UniqueField: "name", DuplicateFormat: `Discovery rule "%s" already exists.`},
+	{Name: "role", IDField: "roleid", UniqueField: "name", DuplicateFormat: `User role with name "%s" already exists.`},
+	{Name: "userdirectory", IDField: "userdirectoryid", UniqueField: "name", DuplicateFormat: `User directory "%s" already exists.`},
+	{Name: "token", IDField: "tokenid", UniqueField: "name", DuplicateFormat: `API token "%s" already exists.`},
+	{Name: "dashboard", IDField: "dashboardid", UniqueField: "name", DuplicateFormat: `Dashboard "%s" already exists.`},
+	{Name: "templatedashboard", IDField: "dashboardid"},
+	{Name: "valuemap", IDField: "valuemapid"},
+	{Name: "map", IDField: "sysmapid", UniqueField: "name", DuplicateFormat: `Map "%s" already exists.`},
+	{Name: "problem", IDField: "eventid"},
+	{Name: "event", IDField: "eventid"},
+}
+
+// DefaultSingletons settings objects registered on every new Server, with
+// the values of a new installation. They are served by <name>.get and
+// changed by <name>.update, which rejects unknown fields. Write only fields
+// listed in writeOnlySettings are accepted but never returned.
+var DefaultSingletons = map[string]map[string]interface{}{
+	"authentication": {
+		"authentication_type":    "0",
+		"http_auth_enabled":      "0",
+		"http_login_form":        "0",
+		"http_strip_domains":     "",
+		"http_case_sensitive":    "1",
+		"ldap_auth_enabled":      "0",
+		"ldap_case_sensitive":    "1",
+		"ldap_userdirectoryid":   "0",
+		"saml_auth_enabled":      "0",
+		"saml_case_sensitive":    "0",
+		"passwd_min_length":      "8",
+		"passwd_check_rules":     "8",
+		"jit_provision_interval": "1h",
+		"saml_jit_status":        "0",
+		"ldap_jit_status":        "0",
+		"disabled_usrgrpid":      "0",
+		"mfa_status":             "0",
+		"mfaid":                  "0",
+	},
+	"settings": {
+		"default_lang":                 "en_US",
+		"default_timezone":             "system",
+		"default_theme":                "blue-theme",
+		"search_limit":                 "1000",
+		"max_overview_table_size":      "50",
+		"max_in_table":                 "50",
+		"server_check_interval":        "10",
+		"work_period":                  "1-5,09:00-18:00",
+		"show_technical_errors":        "0",
+		"history_period":               "24h",
+		"period_default":               "1h",
+		"max_period":                   "2y",
+		"severity_color_0":             "97AAB3",
+		"severity_color_1":             "7499FF",
+		"severity_color_2":             "FFC859",
+		"severity_color_3":             "FFA059",
+		"severity_color_4":             "E97659",
+		"severity_color_5":             "E45959",
+		"severity_name_0":              "Not classified",
+		"severity_name_1":              "Information",
+		"severity_name_2":              "Warning",
+		"severity_name_3":              "Average",
+		"severity_name_4":              "High",
+		"severity_name_5":              "Disaster",
+		"custom_color":                 "0",
+		"ok_period":                    "5m",
+		"blink_period":                 "2m",
+		"problem_unack_color":          "CC0000",
+		"problem_ack_color":            "CC0000",
+		"ok_unack_color":               "009900",
+		"ok_ack_color":                 "009900",
+		"problem_unack_style":          "1",
+		"problem_ack_style":            "1",
+		"ok_unack_style":               "1",
+		"ok_ack_style":                 "1",
+		"url":                          "",
+		"discovery_groupid":            "5",
+		"default_inventory_mode":       "-1",
+		"alert_usrgrpid":               "0",
+		"snmptrap_logging":             "1",
+		"login_attempts":               "5",
+		"login_block":                  "30s",
+		"validate_uri_schemes":         "1",
+		"uri_valid_schemes":            "http,https,ftp,file,mailto,tel,ssh",
+		"x_frame_options":              "SAMEORIGIN",
+		"iframe_sandboxing_enabled":    "1",
+		"iframe_sandboxing_exceptions": "",
+		"auditlog_enabled":             "1",
+		"auditlog_mode":                "1",
+		"ha_failover_delay":            "1m",
+		"vault_provider":               "0",
+		"geomaps_tile_provider":        "OpenStreetMap.Mapnik",
+		"geomaps_tile_url":             "",
+		"geomaps_max_zoom":             "0",
+		"geomaps_attribution":          "",
+		"connect_timeout":              "3s",
+		"socket_timeout":               "3s",
+		"media_type_test_timeout":      "65s",
+		"script_timeout":               "60s",
+		"item_test_timeout":            "60s",
+		"report_test_timeout":          "60s",
+		"timeout_zabbix_agent":         "3s",
+		"timeout_simple_check":         "3s",
+		"timeout_snmp_agent":           "3s",
+		"timeout_external_check":       "3s",
+		"timeout_db_monitor":           "3s",
+		"timeout_http_agent":           "3s",
+		"timeout_ssh_agent":            "3s",
+		"timeout_telnet_agent":         "3s",
+		"timeout_script":               "3s",
+		"timeout_browser":              "60s",
+	},
+	"housekeeping": {
+		"hk_events_mode":           "1",
+		"hk_events_trigger":        "365d",
+		"hk_events_service":        "1d",
+		"hk_events_internal":       "1d",
+		"hk_events_discovery":      "1d",
+		"hk_events_autoreg":        "1d",
+		"hk_services_mode":         "1",
+		"hk_services":              "365d",
+		"hk_audit_mode":            "1",
+		"hk_audit":                 "31d",
+		"hk_sessions_mode":         "1",
+		"hk_sessions":              "31d",
+		"hk_history_mode":          "1",
+		"hk_history_global":        "0",
+		"hk_history":               "31d",
+		"hk_trends_mode":           "1",
+		"hk_trends_global":         "0",
+		"hk_trends":                "365d",
+		"db_extension":             "",
+		"compression_status":       "0",
+		"compress_older":           "7d",
+		"compression_availability": "0",
+	},
+	"autoregistration": {
+		"tls_accept":       "1",
+		"tls_psk_identity": "",
+		"tls_psk":          "",
+	},
+}
+
+// writeOnlySettings fields of settings objects that get never returns.
+var writeOnlySettings = map[string]bool{"tls_psk_identity": true, "tls_psk": true}
+
+// Server is an in-memory fake Zabbix API server.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	version   string
+	users     map[string]string
+	sessions  map[string]string // session ID or token -> username
+	tokens    map[string]string // token ID -> generated token
+	handlers  map[string]HandlerFunc
+	resources map[string]Resource
+	objects   map[string]map[string]map[string]interface{}
+	settings  map[string]map[string]interface{}
+	lastID    int
+}
+
+// NewServer starts a fake server with DefaultResources registered and
+// DefaultUser allowed to log in with DefaultPassword.
+func NewServer() *Server {
+	s := &Server{
+		version:   DefaultVersion,
+		users:     map[string]string{DefaultUser: DefaultPassword},
+		sessions:  map[string]string{},
+		tokens:    map[string]string{},
+		handlers:  map[string]HandlerFunc{},
+		resources: map[string]Resource{},
+		objects:   map[string]map[string]map[string]interface{}{},
+		settings:  map[string]map[string]interface{}{},
+	}
+	for _, r := range DefaultResources {
+		s.AddResource(r)
+	}
+	for name, obj := range DefaultSingletons {
+		s.SetSingleton(name, obj)
+	}
+	s.handlers["event.acknowledge"] = s.acknowledge
+	s.handlers["token.generate"] = s.generateTokens
+	s.handlers["token.delete"] = s.deleteTokens
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// SetVersion sets the version returned by apiinfo.version.
+func (s *Server) SetVersion(v string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version = v
+}
+
+// AddUser allows username to log in with password.
+func (s *Server) AddUser(username, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users[username] = password
+}
+
+// AddToken registers a static API token of DefaultUser accepted for authentication.
+func (s *Server) AddToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[token] = DefaultUser
+}
+
+// ExpireSessions invalidates all sessions and tokens, so the next
+// authenticated call fails like an expired session would.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions = map[string]string{}
+}
+
+// AddResource registers generic get/create/update/delete methods for r.
+func (s *Server) AddResource(r Resource) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resources[r.Name] = r
+	if s.objects[r.Name] == nil {
+		s.objects[r.Name] = map[string]map[string]interface{}{}
+	}
+}
+
+// Handle registers h for method, replacing any generic handler.
+// Handlers run with the server lock released.
+func (s *Server) Handle(method string, h HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[strings.ToLower(method)] = h
+}
+
+// Add stores objects of the named resource as if they were created through the API
+// and returns their IDs.
+func (s *Server) Add(resource string, objects ...map[string]interface{}) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.resources[resource]
+	if !ok {
+		panic("zabbixtest: unknown resource " + resource)
+	}
+	ids := make([]string, len(objects))
+	for i, o := range objects {
+		obj := normalize(copyObject(o)).(map[string]interface{})
+		if id, ok := obj[r.IDField].(string); ok && id != "" {
+			ids[i] = id
+		} else {
+			ids[i] = s.nextID()
+			obj[r.IDField] = ids[i]
+		}
+		s.objects[resource][ids[i]] = obj
+	}
+	return ids
+}
+
+// SetSingleton registers or replaces the settings object served by name.get and name.update.
+func (s *Server) SetSingleton(name string, obj map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.settings[name] = normalize(copyObject(obj)).(map[string]interface{})
+}
+
+// Singleton returns a copy of the settings object name, or nil if there is none.
+func (s *Server) Singleton(name string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.settings[name] == nil {
+		return nil
+	}
+	return copyObject(s.settings[name])
+}
+
+// Objects returns copies of all stored objects of the named resource, ordered by ID.
+func (s *Server) Objects(resource string) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := []map[string]interface{}{}
+	for _, id := range sortedIDs(s.objects[resource]) {
+		res = append(res, copyObject(s.objects[resource][id]))
+	}
+	return res
+}
+
+func (s *Server) nextID() string {
+	s.lastID++
+	return strconv.Itoa(s.lastID)
+}
+
+type request struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	Auth    string          `json:"auth"`
+	ID      interface{}     `json:"id"`
+}
+
+type response struct {
+	Jsonrpc string      `json:"jsonrpc"`
+	Result  interface{} `json:"result,omitempty"`
+	Error   *Error      `json:"error,omitempty"`
+	ID      interface{} `json:"id"`
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	res := response{Jsonrpc: "2.0"}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		res.Error = &Error{Code: CodeParseError, Message: "Parse error.", Data: "Invalid JSON. An error occurred on the server while parsing the JSON text."}
+	} else {
+		res.ID = req.ID
+		auth := req.Auth
+		if h := r.Header.Get("Authorization"); strings.HasPrefix(h, "Bearer ") {
+			auth = strings.TrimPrefix(h, "Bearer ")
+		}
+		result, err := s.call(strings.ToLower(req.Method), req.Params, auth)
+		if err != nil {
+			e, ok := err.(*Error)
+			if !ok {
+				e = &Error{Code: CodeInternalError, Message: "Internal error.", Data: err.Error()}
+			}
+			res.Error = e
+		} else {
+			res.Result = result
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) call(method string, params json.RawMessage, auth string) (interface{}, error) {
+	if method == "" {
+		return nil, InvalidParams(`Invalid parameter "/method": cannot be empty.`)
+	}
+
+	switch method {
+	case "apiinfo.version", "user.login", "user.checkauthentication":
+		if auth != "" {
+			return nil, InvalidParams(`The "%s" method must be called without the "auth" parameter.`, method)
+		}
+	}
+
+	switch method {
+	case "apiinfo.version":
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.version, nil
+	case "user.login":
+		return s.login(params)
+	case "user.checkauthentication":
+		return s.checkAuthentication(params)
+	}
+
+	s.mu.Lock()
+	_, authorized := s.sessions[auth]
+	h, custom := s.handlers[method]
+	s.mu.Unlock()
+
+	if !authorized {
+		if auth != "" {
+			return nil, errSessionTerminated()
+		}
+		return nil, InvalidParams("Not authorized.")
+	}
+	if method == "user.logout" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.sessions, auth)
+		return true, nil
+	}
+	if custom {
+		return h(params)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	parts := strings.SplitN(method, ".", 2)
+	if obj, ok := s.settings[parts[0]]; ok && len(parts) == 2 {
+		switch parts[1] {
+		case "get":
+			return s.getSingleton(obj, params)
+		case "update":
+			return s.updateSingleton(obj, params)
+		}
+	}
+	r, ok := s.resources[parts[0]]
+	if !ok || len(parts) != 2 {
+		return nil, &Error{Code: CodeMethodNotFound, Message: "Method not found.", Data: fmt.Sprintf(`Incorrect API "%s".`, parts[0])}
+	}
+	switch parts[1] {
+	case "get":
+		return s.get(r, params)
+	case "create":
+		return s.create(r, params)
+	case "update":
+		return s.update(r, params)
+	case "delete":
+		return s.delete(r, params)
+	}
+	return nil, &Error{Code: CodeMethodNotFound, Message: "Method not found.", Data: fmt.Sprintf(`Incorrect method "%s".`, method)}
+}
+
+func (s *Server) login(params json.RawMessage) (interface{}, error) {
+	var p struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, InvalidParams("Invalid parameters.")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if pass, ok := s.users[p.Username]; !ok || pass != p.Password {
+		return nil, InvalidParams("Incorrect user name or password or account is temporarily blocked.")
+	}
+	s.lastID++
+	token := fmt.Sprintf("%032x", s.lastID)
+	s.sessions[token] = p.Username
+	return token, nil
+}
+
+// checkAuthentication implements user.checkAuthentication for session IDs and tokens.
+func (s *Server) checkAuthentication(params json.RawMessage) (interface{}, error) {
+	var p struct {
+		SessionID string `json:"sessionid"`
+		Token     string `json:"token"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, InvalidParams("Invalid parameters.")
+	}
+	id := p.SessionID
+	if id == "" {
+		id = p.Token
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	username, ok := s.sessions[id]
+	if !ok {
+		return nil, errSessionTerminated()
+	}
+	res := map[string]interface{}{"username": username, "roleid": "3", "type": "3"}
+	if p.SessionID != "" {
+		res["sessionid"] = p.SessionID
+	}
+	for _, u := range s.objects["user"] {
+		if u["username"] == username {
+			res["userid"] = u["userid"]
+		}
+	}
+	if res["userid"] == nil {
+		res["userid"] = "1"
+	}
+	return res, nil
+}
+
+// generateTokens implements token.generate. Generated tokens authenticate
+// as the owner of the token and replace the previously generated ones.
+func (s *Server) generateTokens(params json.RawMessage) (interface{}, error) {
+	var ids []string
+	if err := json.Unmarshal(params, &ids); err != nil {
+		return nil, InvalidParams(`Invalid parameter "/": an array is expected.`)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		if _, ok := s.objects["token"][id]; !ok {
+			return nil, errNoObject()
+		}
+	}
+	res := make([]map[string]interface{}, len(ids))
+	for i, id := range ids {
+		username := DefaultUser
+		if u, ok := s.objects["user"][fmt.Sprint(s.objects["token"][id]["userid"])]; ok {
+			username = fmt.Sprint(u["username"])
+		}
+		delete(s.sessions, s.tokens[id])
+		s.lastID++
+		token := fmt.Sprintf("%064x", s.lastID)
+		s.tokens[id] = token
+		s.sessions[token] = username
+		res[i] = map[string]interface{}{"tokenid": id, "token": token}
+	}
+	return res, nil
+}
+
+// deleteTokens implements token.delete, revoking the generated tokens.
+func (s *Server) deleteTokens(params json.RawMessage) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res, err := s.delete(s.resources["token"], params)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range res.(map[string]interface{})["tokenids"].([]string) {
+		delete(s.sessions, s.tokens[id])
+		delete(s.tokens, id)
+	}
+	return res, nil
+}
+
+// acknowledge implements event.acknowledge on stored events and problems.
+func (s *Server) acknowledge(params json.RawMessage) (interface{}, error) {
+	var p struct {
+		EventIDs interface{} `json:"eventids"`
+		Action   int         `json:"action"`
+		Severity *int        `json:"severity"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, InvalidParams("Invalid parameters.")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := stringList(p.EventIDs)
+	for _, id := range ids {
+		if _, ok := s.objects["event"][id]; !ok {
+			return nil, errNoObject()
+		}
+	}
+	for _, id := range ids {
+		for _, resource := range []string{"event", "problem"} {
+			obj, ok := s.objects[resource][id]
+			if !ok {
+				continue
+			}
+			if p.Action&2 != 0 {
+				obj["acknowledged"] = "1"
+			}
+			if p.Action&16 != 0 {
+				obj["acknowledged"] = "0"
+			}
+			if p.Action&8 != 0 && p.Severity != nil {
+				obj["severity"] = strconv.Itoa(*p.Severity)
+			}
+			if p.Action&1 != 0 && resource == "problem" {
+				delete(s.objects[resource], id)
+			}
+		}
+	}
+	return map[string]interface{}{"eventids": ids}, nil
+}
+
+// objectList decodes params given either as a single object or an array of objects.
+func objectList(params json.RawMessage) ([]map[string]interface{}, error) {
+	var list []map[string]interface{}
+	if err := json.Unmarshal(params, &list); err == nil {
+		return list, nil
+	}
+	var one map[string]interface{}
+	if err := json.Unmarshal(params, &one); err != nil {
+		return nil, InvalidParams("Invalid parameter \"/\": an array or object is expected.")
+	}
+	return []map[string]interface{}{one}, nil
+}
+
+func (s *Server) checkUnique(r Resource, obj map[string]interface{}, selfID string) error {
+	if r.UniqueField == "" {
+		return nil
+	}
+	value, ok := obj[r.UniqueField].(string)
+	if !ok {
+		return nil
+	}
+	for id, other := range s.objects[r.Name] {
+		if id != selfID && other[r.UniqueField] == value {
+			return InvalidParams(r.DuplicateFormat, value)
+		}
+	}
+	return nil
+}
+
+func (s *Server) create(r Resource, params json.RawMessage) (interface{}, error) {
+	list, err := objectList(params)
+	if err != nil {
+		return nil, err
+	}
+	for i, o := range list {
+		if err := s.checkUnique(r, o, ""); err != nil {
+			return nil, err
+		}
+		for _, prev := range list[:i] {
+			if r.UniqueField != "" && o[r.UniqueField] != nil && prev[r.UniqueField] == o[r.UniqueField] {
+				return nil, InvalidParams(r.DuplicateFormat, o[r.UniqueField])
+			}
+		}
+	}
+
+	ids := make([]string, len(list))
+	for i, o := range list {
+		obj := normalize(o).(map[string]interface{})
+		ids[i] = s.nextID()
+		obj[r.IDField] = ids[i]
+		s.objects[r.Name][ids[i]] = obj
+	}
+	return map[string]interface{}{r.createKey(): ids}, nil
+}
+
+func (s *Server) update(r Resource, params json.RawMessage) (interface{}, error) {
+	list, err := objectList(params)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(list))
+	for i, o := range list {
+		id, _ := normalize(o[r.IDField]).(string)
+		if _, ok := s.objects[r.Name][id]; !ok {
+			return nil, errNoObject()
+		}
+		if err := s.checkUnique(r, o, id); err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	for i, o := range list {
+		stored := s.objects[r.Name][ids[i]]
+		for k, v := range normalize(o).(map[string]interface{}) {
+			stored[k] = v
+		}
+	}
+	return map[string]interface{}{r.createKey(): ids}, nil
+}
+
+func (s *Server) delete(r Resource, params json.RawMessage) (interface{}, error) {
+	var ids []string
+	if err := json.Unmarshal(params, &ids); err != nil {
+		return nil, InvalidParams(`Invalid parameter "/": an array is expected.`)
+	}
+	for _, id := range ids {
+		if _, ok := s.objects[r.Name][id]; !ok {
+			return nil, errNoObject()
+		}
+	}
+	for _, id := range ids {
+		delete(s.objects[r.Name], id)
+	}
+	return map[string]interface{}{r.deleteKey(): ids}, nil
+}
+
+// getSingleton implements <name>.get of a settings object.
+func (s *Server) getSingleton(obj map[string]interface{}, raw json.RawMessage) (interface{}, error) {
+	params := map[string]interface{}{}
+	if len(raw) > 0 && string(raw) != "[]" && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return nil, InvalidParams(`Invalid parameter "/": an array or object is expected.`)
+		}
+	}
+	output := params["output"]
+	res := map[string]interface{}{}
+	for k, v := range obj {
+		if !writeOnlySettings[k] && (output == nil || output == "extend" || containsString(stringList(output), k)) {
+			res[k] = v
+		}
+	}
+	return copyObject(res), nil
+}
+
+// updateSingleton implements <name>.update of a settings object and returns
+// the names of the updated fields, like a real server does.
+func (s *Server) updateSingleton(obj map[string]interface{}, raw json.RawMessage) (interface{}, error) {
+	var params map[string]interface{}
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, InvalidParams(`Invalid parameter "/": an object is expected.`)
+	}
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if _, ok := obj[k]; !ok {
+			return nil, InvalidParams(`Invalid parameter "/": unexpected parameter "%s".`, k)
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for k, v := range normalize(params).(map[string]interface{}) {
+		obj[k] = v
+	}
+	return keys, nil
+}
+
+// selectAliases maps select* parameters to the stored field they return.
+var selectAliases = map[string]string{
+	"selectHostGroups":      "groups",
+	"selectTemplateGroups":  "groups",
+	"selectParentTemplates": "templates",
+	"selectDChecks":         "dchecks",
+}
+
+// selectFields maps select* parameters whose result key differs from the
+// parameter name to the stored field returned under the same key.
+var selectFields = map[string]string{
+	"selectRecoveryOperations": "recovery_operations",
+	"selectUpdateOperations":   "update_operations",
+	"selectMessageTemplates":   "message_templates",
+	"selectTagFilters":         "tag_filters",
+	"selectProvisionMedia":     "provision_media",
+	"selectProvisionGroups":    "provision_groups",
+}
+
+func (s *Server) get(r Resource, raw json.RawMessage) (interface{}, error) {
+	params := map[string]interface{}{}
+	if len(raw) > 0 && string(raw) != "[]" && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return nil, InvalidParams(`Invalid parameter "/": an array or object is expected.`)
+		}
+	}
+
+	var matched []map[string]interface{}
+	for _, id := range sortedIDs(s.objects[r.Name]) {
+		obj := s.objects[r.Name][id]
+		if matches(r, obj, params) {
+			matched = append(matched, obj)
+		}
+	}
+
+	if field, ok := params["sortfield"]; ok {
+		sortObjects(matched, stringList(field), params["sortorder"])
+	}
+	if limit, err := strconv.Atoi(fmt.Sprint(normalize(params["limit"]))); err == nil && limit > 0 && limit < len(matched) {
+		matched = matched[:limit]
+	}
+
+	if isTrue(params["countOutput"]) {
+		return strconv.Itoa(len(matched)), nil
+	}
+
+	res := make([]map[string]interface{}, 0, len(matched))
+	for _, obj := range matched {
+		res = append(res, project(r, obj, params))
+	}
+	return res, nil
+}
+
+// matches applies ID, filter and search parameters of a get request.
+func matches(r Resource, obj map[string]interface{}, params map[string]interface{}) bool {
+	for key, value := range params {
+		switch {
+		case key == "filter":
+			filter, _ := value.(map[string]interface{})
+			for field, want := range filter {
+				if !containsString(stringList(want), fmt.Sprint(normalize(obj[field]))) {
+					return false
+				}
+			}
+		case key == "search":
+			search, _ := value.(map[string]interface{})
+			for field, want := range search {
+				got := strings.ToLower(fmt.Sprint(normalize(obj[field])))
+				found := false
+				for _, w := range stringList(want) {
+					if strings.Contains(got, strings.ToLower(strings.Trim(w, "*"))) {
+						found = true
+					}
+				}
+				if !found && !isTrue(params["searchByAny"]) {
+					return false
+				}
+			}
+		case key == r.IDField+"_from":
+			// paging by ID, like "eventid_from" of event.get
+			from, err := strconv.ParseUint(fmt.Sprint(normalize(value)), 10, 64)
+			id, _ := strconv.ParseUint(obj[r.IDField].(string), 10, 64)
+			if err == nil && id < from {
+				return false
+			}
+		case key == r.idsParam():
+			if !containsString(stringList(value), obj[r.IDField].(string)) {
+				return false
+			}
+		case strings.HasSuffix(key, "ids"):
+			field, ok := r.References[key]
+			if !ok {
+				field = strings.TrimSuffix(key, "s")
+			}
+			if !referencesAny(obj, field, stringList(value)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// referencesAny reports whether obj refers to one of ids through field,
+// either directly or inside one of its nested object lists.
+func referencesAny(obj map[string]interface{}, field string, ids []string) bool {
+	if v, ok := obj[field].(string); ok {
+		return containsString(ids, v)
+	}
+	for _, v := range obj {
+		list, ok := v.([]interface{})
+		if !ok {
+			continue
+		}
+		for _, e := range list {
+			if m, ok := e.(map[string]interface{}); ok {
+				if id, ok := m[field].(string); ok && containsString(ids, id) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// project applies output and select* parameters. Nested object lists are
+// only returned when requested through a select* parameter, like on a real server.
+func project(r Resource, obj map[string]interface{}, params map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{}
+	output := params["output"]
+	if output == nil || output == "extend" {
+		for k, v := range obj {
+			if _, nested := v.([]interface{}); !nested || r.listField(k) {
+				res[k] = v
+			}
+		}
+	} else {
+		for _, field := range stringList(output) {
+			if v, ok := obj[field]; ok {
+				res[field] = v
+			}
+		}
+		res[r.IDField] = obj[r.IDField]
+	}
+
+	for key, value := range params {
+		if !strings.HasPrefix(key, "select") || value == nil {
+			continue
+		}
+		name := strings.ToLower(key[6:7]) + key[7:]
+		source := name
+		if alias, ok := selectAliases[key]; ok {
+			source = alias
+		}
+		if field, ok := selectFields[key]; ok {
+			name, source = field, field
+		}
+		if v, ok := obj[source]; ok {
+			res[name] = v
+		}
+	}
+	return copyObject(res)
+}
+
+func sortObjects(list []map[string]interface{}, fields []string, order interface{}) {
+	desc := strings.EqualFold(fmt.Sprint(order), "DESC")
+	sort.SliceStable(list, func(i, j int) bool {
+		for _, f := range fields {
+			a, b := fmt.Sprint(list[i][f]), fmt.Sprint(list[j][f])
+			if a == b {
+				continue
+			}
+			less := a < b
+			if ai, err := strconv.ParseFloat(a, 64); err == nil {
+				if bi, err := strconv.ParseFloat(b, 64); err == nil {
+					less = ai < bi
+				}
+			}
+			return less != desc
+		}
+		return false
+	})
+}
+
+// normalize converts numbers and booleans to strings, recursively,
+// the way a real server returns them.
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			res[k] = normalize(e)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(t))
+		for i, e := range t {
+			res[i] = normalize(e)
+		}
+		return res
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		if t {
+			return "1"
+		}
+		return "0"
+	}
+	return v
+}
+
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	b, _ := json.Marshal(obj)
+	var res map[string]interface{}
+	json.Unmarshal(b, &res)
+	return res
+}
+
+func stringList(v interface{}) []string {
+	switch t := normalize(v).(type) {
+	case []interface{}:
+		res := make([]string, 0, len(t))
+		for _, e := range t {
+			res = append(res, fmt.Sprint(e))
+		}
+		return res
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprint(t)}
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isTrue(v interface{}) bool {
+	switch fmt.Sprint(normalize(v)) {
+	case "1", "true":
+		return true
+	}
+	return false
+}
+
+func sortedIDs(objects map[string]map[string]interface{}) []string {
+	ids := make([]string, 0, len(objects))
+	for id := range objects {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, _ := strconv.Atoi(ids[i])
+		b, _ := strconv.Atoi(ids[j])
+		if a != b {
+			return a < b
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+}
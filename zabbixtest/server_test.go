@@ -0,0 +1,302 @@
+package zabbixtest_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	zapi "github.com/kgeroczi/go-zabbix-api"
+	"github.com/kgeroczi/go-zabbix-api/zabbixtest"
+)
+
+func newAPI(t *testing.T) (*zapi.API, *zabbixtest.Server) {
+	t.Helper()
+	srv := zabbixtest.NewServer()
+	t.Cleanup(srv.Close)
+
+	api, err := zapi.NewAPI(zapi.Config{Url: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Login(zabbixtest.DefaultUser, zabbixtest.DefaultPassword); err != nil {
+		t.Fatal(err)
+	}
+	return api, srv
+}
+
+func TestVersionAndLogin(t *testing.T) {
+	srv := zabbixtest.NewServer()
+	defer srv.Close()
+
+	api, err := zapi.NewAPI(zapi.Config{Url: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api.Config.Version != 70000 {
+		t.Errorf("expected version 70000, got %d", api.Config.Version)
+	}
+
+	if _, err := api.HostsGet(zapi.Params{}); err == nil {
+		t.Error("expected error for unauthenticated call")
+	}
+	if _, err := api.Login("Admin", "wrong"); err == nil {
+		t.Error("expected error for wrong password")
+	}
+	if _, err := api.Login(zabbixtest.DefaultUser, zabbixtest.DefaultPassword); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.HostsGet(zapi.Params{}); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.AddToken("static-token")
+	api.Token("static-token")
+	if _, err := api.HostsGet(zapi.Params{}); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.ExpireSessions()
+	if _, err := api.HostsGet(zapi.Params{}); err == nil {
+		t.Error("expected error after sessions expired")
+	}
+}
+
+func TestHostCRUD(t *testing.T) {
+	api, srv := newAPI(t)
+
+	groups := zapi.HostGroups{{Name: "linux servers"}}
+	if err := api.HostGroupsCreate(groups); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.HostGroupsCreate(zapi.HostGroups{{Name: "linux servers"}}); err == nil {
+		t.Error("expected error for duplicate host group")
+	}
+
+	hosts := zapi.Hosts{{
+		Host:         "web01",
+		Name:         "Web 01",
+		Status:       zapi.Unmonitored,
+		HostGroupIds: zapi.HostGroupIDs{{GroupID: groups[0].GroupID}},
+		Interfaces:   zapi.HostInterfaces{{IP: "10.0.0.1", Main: "1", Port: "10050", Type: zapi.Agent, UseIP: "1"}},
+		Tags:         zapi.Tags{{Tag: "env", Value: "prod"}},
+	}}
+	if err := api.HostsCreate(hosts); err != nil {
+		t.Fatal(err)
+	}
+	if hosts[0].HostID == "" {
+		t.Fatal("host id is empty after create")
+	}
+
+	host, err := api.HostGetByHost("web01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host.HostID != hosts[0].HostID || host.Status != zapi.Unmonitored || host.Name != "Web 01" {
+		t.Errorf("unexpected host %#v", host)
+	}
+	if len(host.Interfaces) != 0 || len(host.Tags) != 0 {
+		t.Error("nested objects returned without select parameter")
+	}
+
+	res, err := api.HostsGet(zapi.Params{"groupids": groups[0].GroupID, "selectInterfaces": "extend", "selectTags": "extend"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || len(res[0].Interfaces) != 1 || res[0].Tags[0].Value != "prod" {
+		t.Errorf("unexpected hosts %#v", res)
+	}
+
+	hosts[0].Name = "Web 01 renamed"
+	if err := api.HostsUpdate(hosts); err != nil {
+		t.Fatal(err)
+	}
+	host, err = api.HostGetByID(hosts[0].HostID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if host.Name != "Web 01 renamed" {
+		t.Errorf("host was not updated: %#v", host)
+	}
+
+	if err := api.HostsDelete(hosts); err != nil {
+		t.Fatal(err)
+	}
+	if len(srv.Objects("host")) != 0 {
+		t.Error("host was not deleted")
+	}
+	if err := api.HostsDeleteByIds([]string{"12345"}); err == nil {
+		t.Error("expected error deleting unknown host")
+	}
+}
+
+func TestItemsAndTriggers(t *testing.T) {
+	api, _ := newAPI(t)
+
+	hosts := zapi.Hosts{{Host: "db01", Name: "db01"}}
+	if err := api.HostsCreate(hosts); err != nil {
+		t.Fatal(err)
+	}
+
+	items := zapi.Items{{
+		HostID:    hosts[0].HostID,
+		Key:       "db.connections",
+		Name:      "Connections",
+		Type:      zapi.ZabbixTrapper,
+		ValueType: zapi.Unsigned,
+	}}
+	if err := api.ItemsCreate(items); err != nil {
+		t.Fatal(err)
+	}
+	item, err := api.ItemGetByID(items[0].ItemID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Type != zapi.ZabbixTrapper || item.ValueType != zapi.Unsigned || item.Key != "db.connections" {
+		t.Errorf("unexpected item %#v", item)
+	}
+	byHost, err := api.ItemsGet(zapi.Params{"hostids": hosts[0].HostID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(byHost) != 1 {
+		t.Errorf("expected 1 item for host, got %d", len(byHost))
+	}
+
+	triggers := zapi.Triggers{{
+		Description: "Too many connections",
+		Expression:  "last(/db01/db.connections)>100",
+		Priority:    zapi.High,
+	}}
+	if err := api.TriggersCreate(triggers); err != nil {
+		t.Fatal(err)
+	}
+	trigger, err := api.TriggerGetByID(triggers[0].TriggerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trigger.Priority != zapi.High {
+		t.Errorf("unexpected trigger priority %d", trigger.Priority)
+	}
+	if err := api.TriggersDelete(triggers); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.ItemsDelete(items); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTemplatesAndMacros(t *testing.T) {
+	api, _ := newAPI(t)
+
+	groups := zapi.TemplateGroups{{Name: "Templates"}}
+	if err := api.TemplateGroupsCreate(groups); err != nil {
+		t.Fatal(err)
+	}
+	templates := zapi.Templates{{Host: "Template App", Groups: zapi.TemplateGroupIDs{{GroupID: groups[0].GroupID}}}}
+	if err := api.TemplatesCreate(templates); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.TemplateGetByID(templates[0].TemplateID); err != nil {
+		t.Fatal(err)
+	}
+
+	macros := zapi.Macros{{HostID: templates[0].TemplateID, MacroName: "{$PORT}", Value: "8080"}}
+	if err := api.MacrosCreate(macros); err != nil {
+		t.Fatal(err)
+	}
+	res, err := api.MacrosGet(zapi.Params{"hostids": templates[0].TemplateID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 1 || res[0].Value != "8080" {
+		t.Errorf("unexpected macros %#v", res)
+	}
+	if err := api.MacrosDelete(macros); err != nil {
+		t.Fatal(err)
+	}
+	if err := api.TemplatesDelete(templates); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetParameters(t *testing.T) {
+	api, srv := newAPI(t)
+	srv.Add("hostgroup",
+		map[string]interface{}{"name": "b"},
+		map[string]interface{}{"name": "a"},
+		map[string]interface{}{"name": "c"},
+	)
+
+	groups, err := api.HostGroupsGet(zapi.Params{"sortfield": "name", "limit": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 || groups[0].Name != "a" || groups[1].Name != "b" {
+		t.Errorf("unexpected groups %#v", groups)
+	}
+
+	groups, err = api.HostGroupsGet(zapi.Params{"filter": map[string]interface{}{"name": []string{"a", "c"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 {
+		t.Errorf("expected 2 filtered groups, got %d", len(groups))
+	}
+
+	res, err := api.CallWithError("hostgroup.get", zapi.Params{"countOutput": true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Result != "3" {
+		t.Errorf("expected count \"3\", got %v", res.Result)
+	}
+
+	res, err = api.Call("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Error == nil || res.Error.Code != zabbixtest.CodeInvalidParams {
+		t.Errorf("expected code %d, got %v", zabbixtest.CodeInvalidParams, res.Error)
+	}
+}
+
+func TestCustomHandler(t *testing.T) {
+	api, srv := newAPI(t)
+	srv.Handle("history.get", func(params json.RawMessage) (interface{}, error) {
+		return []map[string]string{{"itemid": "1", "clock": "1700000000", "value": "1.5", "ns": "0"}}, nil
+	})
+
+	res, err := api.CallWithError("history.get", zapi.Params{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list, ok := res.Result.([]interface{}); !ok || len(list) != 1 {
+		t.Errorf("unexpected result %#v", res.Result)
+	}
+}
+
+func TestSingletons(t *testing.T) {
+	api, srv := newAPI(t)
+	srv.SetSingleton("settings", map[string]interface{}{"default_theme": "blue-theme", "max_period": "2y"})
+
+	res, err := api.CallWithError("settings.update", zapi.Params{"default_theme": "dark-theme"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list, ok := res.Result.([]interface{}); !ok || len(list) != 1 || list[0] != "default_theme" {
+		t.Errorf("unexpected result %#v", res.Result)
+	}
+	if _, err = api.CallWithError("settings.update", zapi.Params{"unknown": "1"}); err == nil {
+		t.Error("expected error for unknown field")
+	}
+
+	res, err = api.CallWithError("settings.get", zapi.Params{"output": []string{"default_theme"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if obj, ok := res.Result.(map[string]interface{}); !ok || len(obj) != 1 || obj["default_theme"] != "dark-theme" {
+		t.Errorf("unexpected result %#v", res.Result)
+	}
+	if srv.Singleton("settings")["max_period"] != "2y" {
+		t.Errorf("unexpected settings %v", srv.Singleton("settings"))
+	}
+}